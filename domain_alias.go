@@ -0,0 +1,74 @@
+package utils
+
+import (
+	"cmp"
+	"strings"
+
+	"github.com/surfe/utils/domainlist"
+)
+
+// CompanyDomainAliases maps domains to the canonical domain of their company, e.g. "amazon.de" to "amazon.com".
+// It is used by MatchDomains, by SameDomains except for acquisitions, and by DomainFromURLBypassingShortener for
+// brand and country aliases only. It may be extended at runtime, e.g. with LoadFile.
+var CompanyDomainAliases = domainlist.DefaultAliases(domainlist.CompanyDomainAliases)
+
+// DomainMatchReason is why MatchDomains considers two domains the same.
+type DomainMatchReason string
+
+const (
+	DomainMatchNone        DomainMatchReason = ""
+	DomainMatchSame        DomainMatchReason = "same_domain"
+	DomainMatchAcquisition                   = DomainMatchReason(domainlist.AliasAcquisition)
+	DomainMatchRebrand                       = DomainMatchReason(domainlist.AliasRebrand)
+	DomainMatchCountry                       = DomainMatchReason(domainlist.AliasCountry)
+	DomainMatchBrand                         = DomainMatchReason(domainlist.AliasBrand)
+)
+
+// MatchDomains returns why crmDomain, a domain or URL, has the domain linkedinDomain: it's the same domain, or both
+// have the same canonical domain in CompanyDomainAliases, in which case the reason is the kind of the alias, that of
+// linkedinDomain first. Internationalized domains match whatever their form, e.g. "müller.de" and
+// "https://xn--mller-kva.de". It returns DomainMatchNone when they don't match. Examples:
+//
+//	MatchDomains("surfe.com", "https://www.surfe.com") -> DomainMatchSame
+//	MatchDomains("amazon.com", "amazon.de")            -> DomainMatchCountry
+//	MatchDomains("tableau.com", "salesforce.com")      -> DomainMatchAcquisition
+func MatchDomains(linkedinDomain, crmDomain string) DomainMatchReason {
+	if linkedinDomain == "" || crmDomain == "" {
+		return DomainMatchNone
+	}
+
+	crm := DomainFromURL(crmDomain, DomainFormASCII)
+	if crm == "" {
+		crm = asciiDomain(crmDomain)
+	}
+
+	if strings.EqualFold(crm, asciiDomain(linkedinDomain)) {
+		return DomainMatchSame
+	}
+
+	linkedin := DomainFromURL(linkedinDomain, DomainFormASCII)
+	if linkedin == "" {
+		return DomainMatchNone
+	}
+
+	linkedinAlias, linkedinIsAlias := CompanyDomainAliases.Lookup(linkedin)
+	crmAlias, crmIsAlias := CompanyDomainAliases.Lookup(crm)
+
+	switch {
+	case CompanyDomainAliases.Canonical(linkedin) != CompanyDomainAliases.Canonical(crm):
+		return DomainMatchNone
+	case linkedinIsAlias:
+		return DomainMatchReason(linkedinAlias.Kind)
+	case crmIsAlias:
+		return DomainMatchReason(crmAlias.Kind)
+	default:
+		return DomainMatchNone // linkedinDomain must be a bare domain, e.g. not "https://surfe.com"
+	}
+}
+
+// isAcquisitionAlias reports whether domain, a domain or URL, is that of a company acquired by another one.
+func isAcquisitionAlias(domain string) bool {
+	alias, found := CompanyDomainAliases.Lookup(cmp.Or(DomainFromURL(domain, DomainFormASCII), domain))
+
+	return found && alias.Kind == domainlist.AliasAcquisition
+}
@@ -0,0 +1,93 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMatchDomains(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		linkedinDomain string
+		crmDomain      string
+		expected       DomainMatchReason
+	}{
+		{
+			name:           "Given same domains should return same domain",
+			linkedinDomain: "surfe.com",
+			crmDomain:      "https://www.surfe.com/",
+			expected:       DomainMatchSame,
+		},
+		{
+			name:           "Given country domain should return country",
+			linkedinDomain: "amazon.com",
+			crmDomain:      "https://www.amazon.de",
+			expected:       DomainMatchCountry,
+		},
+		{
+			name:           "Given country domains of the same company should return country",
+			linkedinDomain: "amazon.fr",
+			crmDomain:      "amazon.co.uk",
+			expected:       DomainMatchCountry,
+		},
+		{
+			name:           "Given acquired company domain should return acquisition",
+			linkedinDomain: "tableau.com",
+			crmDomain:      "salesforce.com",
+			expected:       DomainMatchAcquisition,
+		},
+		{
+			name:           "Given former domain should return rebrand",
+			linkedinDomain: "block.xyz",
+			crmDomain:      "squareup.com",
+			expected:       DomainMatchRebrand,
+		},
+		{
+			name:           "Given brand short domain should return brand",
+			linkedinDomain: "google.com",
+			crmDomain:      "goo.gle",
+			expected:       DomainMatchBrand,
+		},
+		{
+			name:           "Given aliases of different companies should return none",
+			linkedinDomain: "amazon.de",
+			crmDomain:      "google.de",
+			expected:       DomainMatchNone,
+		},
+		{
+			name:           "Given different domains should return none",
+			linkedinDomain: "leadjet.io",
+			crmDomain:      "surfe.com",
+			expected:       DomainMatchNone,
+		},
+		{
+			name:           "Given empty domain should return none",
+			linkedinDomain: "amazon.com",
+			expected:       DomainMatchNone,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tt.expected, MatchDomains(tt.linkedinDomain, tt.crmDomain))
+			require.Equal(t, tt.expected != DomainMatchNone && tt.expected != DomainMatchAcquisition,
+				SameDomains(tt.linkedinDomain, tt.crmDomain))
+		})
+	}
+}
+
+func TestDomainFromURLBypassingShortenerAliases(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, "google.com", DomainFromURLBypassingShortener("https://goo.gle/gemini"))
+	require.Equal(t, "amazon.com", DomainFromURLBypassingShortener("www.amazon.co.uk/gp/help"))
+	require.Equal(t, "surfe.com", DomainFromURLBypassingShortener("https://www.surfe.com"))
+	require.Equal(t, "tableau.com", DomainFromURLBypassingShortener("https://www.tableau.com"))
+	require.Equal(t, "squareup.com", DomainFromURLBypassingShortener("squareup.com/us/en"))
+	require.Equal(t, DomainMatchAcquisition, MatchDomains("salesforce.com", "https://www.tableau.com"))
+}
@@ -0,0 +1,177 @@
+package utils
+
+import (
+	"cmp"
+	"context"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	defaultResolveDomainsConcurrency = 8
+	defaultDomainCacheTTL            = 24 * time.Hour
+	defaultDomainCacheNegativeTTL    = time.Hour
+)
+
+// ResolveDomainsOptions configures ResolveDomains.
+type ResolveDomainsOptions struct {
+	Resolver    *RedirectResolver // Follows the redirects of URL shorteners, DefaultRedirectResolver when nil
+	Concurrency int               // Maximum number of URLs resolved at once, 8 when zero
+	Cache       *DomainCache      // Shares results across calls, results aren't cached when nil
+}
+
+// DomainResolution is the result of ResolveDomains for one URL.
+type DomainResolution struct {
+	URL    string // URL as given
+	Domain string // As returned by DomainFromURLBypassingShortener, e.g. the shortener's when its redirect failed
+	Err    error  // Why the domain couldn't be resolved, e.g. a shortened URL without redirect
+	Cached bool   // Result was read from the cache
+}
+
+// ResolveDomains is DomainFromURLBypassingShortener for many URLs: identical URLs are resolved once, at most
+// Concurrency URLs are resolved at once, and results, errors included, are shared through the cache. Results are
+// in the order of rawURLs. When ctx is done, the URLs not resolved yet get its error, which is never cached.
+func ResolveDomains(ctx context.Context, rawURLs []string, opts ResolveDomainsOptions) []DomainResolution {
+	resolver := cmp.Or(opts.Resolver, DefaultRedirectResolver)
+
+	resolutions := make(map[string]*DomainResolution, len(rawURLs))
+	pending := make(chan *DomainResolution, len(rawURLs))
+
+	for _, rawURL := range rawURLs {
+		key := strings.TrimSpace(rawURL)
+		if _, exists := resolutions[key]; exists {
+			continue
+		}
+
+		resolution := &DomainResolution{URL: key}
+		resolutions[key] = resolution
+
+		if entry, found := opts.Cache.get(key); found {
+			resolution.Domain, resolution.Err, resolution.Cached = entry.domain, entry.err, true
+
+			continue
+		}
+
+		pending <- resolution
+	}
+
+	close(pending)
+
+	var wg sync.WaitGroup
+
+	for range min(cmp.Or(opts.Concurrency, defaultResolveDomainsConcurrency), len(pending)) {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for resolution := range pending {
+				if err := ctx.Err(); err != nil {
+					resolution.Err = err
+
+					continue
+				}
+
+				resolution.Domain, resolution.Err = companyDomain(ctx, resolver, resolution.URL)
+				if resolution.Err == nil || ctx.Err() == nil {
+					opts.Cache.set(resolution.URL, resolution.Domain, resolution.Err)
+				}
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	results := make([]DomainResolution, len(rawURLs))
+
+	for i, rawURL := range rawURLs {
+		results[i] = *resolutions[strings.TrimSpace(rawURL)]
+		results[i].URL = rawURL
+	}
+
+	return results
+}
+
+// DomainCache caches the results of ResolveDomains, successes for TTL and errors for NegativeTTL.
+// The zero value is ready to use, a DomainCache must not be copied after first use.
+type DomainCache struct {
+	TTL         time.Duration // 24h when zero
+	NegativeTTL time.Duration // 1h when zero
+
+	mutex     sync.Mutex
+	entries   map[string]domainCacheEntry
+	lastSweep time.Time
+	now       func() time.Time // time.Now when nil, replaced in tests
+}
+
+type domainCacheEntry struct {
+	domain  string
+	err     error
+	expires time.Time
+}
+
+// Len returns the number of cached results, expired ones included until they are swept.
+func (c *DomainCache) Len() int {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	return len(c.entries)
+}
+
+func (c *DomainCache) get(key string) (domainCacheEntry, bool) {
+	if c == nil {
+		return domainCacheEntry{}, false
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	entry, found := c.entries[key]
+	if !found || !c.clock().Before(entry.expires) {
+		return domainCacheEntry{}, false
+	}
+
+	return entry, true
+}
+
+func (c *DomainCache) set(key, domain string, err error) {
+	if c == nil {
+		return
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	now := c.clock()
+	ttl := cmp.Or(c.TTL, defaultDomainCacheTTL)
+
+	if err != nil {
+		ttl = cmp.Or(c.NegativeTTL, defaultDomainCacheNegativeTTL)
+	}
+
+	if c.entries == nil {
+		c.entries = make(map[string]domainCacheEntry)
+	}
+
+	// Expired entries are dropped at most once per negative TTL, the shortest one in practice
+	if now.Sub(c.lastSweep) >= cmp.Or(c.NegativeTTL, defaultDomainCacheNegativeTTL) {
+		for cachedKey, entry := range c.entries {
+			if !now.Before(entry.expires) {
+				delete(c.entries, cachedKey)
+			}
+		}
+
+		c.lastSweep = now
+	}
+
+	c.entries[key] = domainCacheEntry{domain: domain, err: err, expires: now.Add(ttl)}
+}
+
+func (c *DomainCache) clock() time.Time {
+	if c.now == nil {
+		return time.Now()
+	}
+
+	return c.now()
+}
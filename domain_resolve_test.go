@@ -0,0 +1,181 @@
+package utils
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// fakeShortenerServer serves bit.ly redirects to www.acme.com, whatever the host dialed, and counts requests by URL.
+// bit.ly/dead doesn't redirect, and bit.ly/unreachable redirects to a host of the reserved .invalid TLD.
+type fakeShortenerServer struct {
+	*httptest.Server
+
+	mutex    sync.Mutex
+	requests map[string]int
+
+	delay     time.Duration
+	active    atomic.Int32
+	maxActive atomic.Int32
+}
+
+func startFakeShortenerServer(t *testing.T, delay time.Duration) *fakeShortenerServer {
+	t.Helper()
+
+	server := &fakeShortenerServer{requests: make(map[string]int), delay: delay}
+	server.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		active := server.active.Add(1)
+		defer server.active.Add(-1)
+
+		for maxActive := server.maxActive.Load(); active > maxActive; maxActive = server.maxActive.Load() {
+			if server.maxActive.CompareAndSwap(maxActive, active) {
+				break
+			}
+		}
+
+		server.mutex.Lock()
+		server.requests[r.Host+r.URL.Path]++
+		server.mutex.Unlock()
+
+		time.Sleep(server.delay)
+
+		switch {
+		case r.Host != "bit.ly", r.URL.Path == "/dead":
+		case r.URL.Path == "/unreachable":
+			http.Redirect(w, r, "http://www.acme-unreachable.invalid/home", http.StatusMovedPermanently)
+		default:
+			http.Redirect(w, r, "http://www.acme.com/", http.StatusMovedPermanently)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func (s *fakeShortenerServer) requestCount(url string) int {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.requests[url]
+}
+
+// resolver returns a RedirectResolver connecting to the server for every host but those of the .invalid TLD.
+func (s *fakeShortenerServer) resolver() *RedirectResolver {
+	transport := &http.Transport{
+		DialContext: func(ctx context.Context, network, address string) (net.Conn, error) {
+			if host, _, _ := net.SplitHostPort(address); strings.HasSuffix(host, ".invalid") {
+				return nil, &net.DNSError{Err: "no such host", Name: host, IsNotFound: true}
+			}
+
+			return (&net.Dialer{}).DialContext(ctx, network, s.Listener.Addr().String())
+		},
+	}
+
+	return &RedirectResolver{Client: &http.Client{Transport: transport}}
+}
+
+func TestResolveDomains(t *testing.T) {
+	t.Parallel()
+
+	server := startFakeShortenerServer(t, 0)
+
+	results := ResolveDomains(context.Background(), []string{
+		"http://bit.ly/abc",
+		"http://bit.ly/abc",
+		" http://bit.ly/abc ",
+		"https://www.linkedin.com/company/acme",
+		"http://bit.ly/dead",
+		"",
+		"acme.io/about",
+	}, ResolveDomainsOptions{Resolver: server.resolver()})
+
+	require.Len(t, results, 7)
+
+	for i, expected := range []DomainResolution{
+		{URL: "http://bit.ly/abc", Domain: "acme.com"},
+		{URL: "http://bit.ly/abc", Domain: "acme.com"},
+		{URL: " http://bit.ly/abc ", Domain: "acme.com"},
+		{URL: "https://www.linkedin.com/company/acme"},
+		{URL: "http://bit.ly/dead", Domain: "bit.ly", Err: ErrNoRedirects},
+		{URL: "", Err: ErrEmptyURL},
+		{URL: "acme.io/about", Domain: "acme.io"},
+	} {
+		require.Equal(t, expected.URL, results[i].URL, i)
+		require.Equal(t, expected.Domain, results[i].Domain, i)
+		require.ErrorIs(t, results[i].Err, expected.Err, i)
+		require.False(t, results[i].Cached, i)
+	}
+
+	require.Equal(t, 1, server.requestCount("bit.ly/abc"))
+}
+
+func TestResolveDomainsCache(t *testing.T) {
+	t.Parallel()
+
+	server := startFakeShortenerServer(t, 0)
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	cache := &DomainCache{TTL: time.Hour, NegativeTTL: time.Minute, now: func() time.Time { return now }}
+	opts := ResolveDomainsOptions{Resolver: server.resolver(), Cache: cache}
+	rawURLs := []string{"http://bit.ly/abc", "http://bit.ly/dead"}
+
+	results := ResolveDomains(context.Background(), rawURLs, opts)
+	require.Equal(t, "acme.com", results[0].Domain)
+	require.ErrorIs(t, results[1].Err, ErrNoRedirects)
+	require.Equal(t, 2, cache.Len())
+
+	results = ResolveDomains(context.Background(), rawURLs, opts)
+	require.True(t, results[0].Cached)
+	require.Equal(t, "acme.com", results[0].Domain)
+	require.True(t, results[1].Cached)
+	require.ErrorIs(t, results[1].Err, ErrNoRedirects)
+	require.Equal(t, 1, server.requestCount("bit.ly/abc"))
+	require.Equal(t, 1, server.requestCount("bit.ly/dead"))
+
+	// Errors expire before successes
+	now = now.Add(2 * time.Minute)
+
+	results = ResolveDomains(context.Background(), rawURLs, opts)
+	require.True(t, results[0].Cached)
+	require.False(t, results[1].Cached)
+	require.Equal(t, 1, server.requestCount("bit.ly/abc"))
+	require.Equal(t, 2, server.requestCount("bit.ly/dead"))
+
+	// Cancelled resolutions aren't cached
+	now = now.Add(2 * time.Hour)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	results = ResolveDomains(ctx, rawURLs, opts)
+	require.ErrorIs(t, results[0].Err, context.Canceled)
+	require.ErrorIs(t, results[1].Err, context.Canceled)
+
+	results = ResolveDomains(context.Background(), rawURLs, opts)
+	require.False(t, results[0].Cached)
+	require.Equal(t, "acme.com", results[0].Domain)
+}
+
+func TestResolveDomainsConcurrency(t *testing.T) {
+	t.Parallel()
+
+	server := startFakeShortenerServer(t, 20*time.Millisecond)
+
+	results := ResolveDomains(context.Background(), []string{
+		"http://bit.ly/1", "http://bit.ly/2", "http://bit.ly/3", "http://bit.ly/4", "http://bit.ly/5", "http://bit.ly/6",
+	}, ResolveDomainsOptions{Resolver: server.resolver(), Concurrency: 2})
+
+	for _, result := range results {
+		require.NoError(t, result.Err)
+		require.Equal(t, "acme.com", result.Domain)
+	}
+
+	require.Equal(t, int32(2), server.maxActive.Load())
+}
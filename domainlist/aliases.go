@@ -0,0 +1,171 @@
+package domainlist
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// CompanyDomainAliases is the name of the embedded default aliases of company domains, see DefaultAliases.
+const CompanyDomainAliases = "company_domain_aliases"
+
+// AliasKind is why a domain is an alias of another one.
+type AliasKind string
+
+const (
+	AliasAcquisition AliasKind = "acquisition" // Domain of an acquired company, e.g. tableau.com for salesforce.com
+	AliasRebrand     AliasKind = "rebrand"     // Former domain of a company, e.g. squareup.com for block.xyz
+	AliasCountry     AliasKind = "country"     // Country domain of a company, e.g. amazon.de for amazon.com
+	AliasBrand       AliasKind = "brand"       // Short or product domain of a company, e.g. goo.gle for google.com
+)
+
+// aliasKinds are the kinds accepted by Aliases.Load.
+var aliasKinds = []AliasKind{AliasAcquisition, AliasRebrand, AliasCountry, AliasBrand}
+
+// Alias is a domain equivalent to a canonical domain of the same company.
+type Alias struct {
+	Domain    string
+	Canonical string
+	Kind      AliasKind
+}
+
+// Aliases maps domains to the canonical domain of their company, safe for concurrent use.
+// Domains are normalized as in DomainList. Canonical domains aren't resolved further.
+type Aliases struct {
+	mu      sync.RWMutex
+	aliases map[string]Alias
+}
+
+// NewAliases returns an empty set of aliases.
+func NewAliases() *Aliases {
+	return &Aliases{aliases: make(map[string]Alias)}
+}
+
+// DefaultAliases returns new aliases loaded from the embedded default aliases name, e.g. CompanyDomainAliases.
+// It panics when there are no such aliases.
+func DefaultAliases(name string) *Aliases {
+	f, err := lists.Open("lists/" + name + ".txt")
+	if err != nil {
+		panic("domainlist: unknown default aliases " + name)
+	}
+	defer f.Close()
+
+	a := NewAliases()
+	if err := a.Load(f); err != nil {
+		panic("domainlist: failed to load default aliases " + name + ": " + err.Error())
+	}
+
+	return a
+}
+
+// Load adds the aliases read from r, one canonical domain per line followed by the kind and the domains aliasing it,
+// e.g. "amazon.com country amazon.de amazon.fr". Blank lines and comments, from "#" to the end of line, are skipped.
+// It returns an error for lines without kind or alias, or with an unknown kind, in which case no alias is added.
+func (a *Aliases) Load(r io.Reader) error {
+	var aliases []Alias
+
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		fields := strings.Fields(scanner.Text())
+		isComment := func(field string) bool { return strings.HasPrefix(field, "#") }
+		if comment := slices.IndexFunc(fields, isComment); comment >= 0 {
+			fields = fields[:comment]
+		}
+
+		if len(fields) == 0 {
+			continue
+		}
+
+		if len(fields) < 3 || strings.Contains(fields[1], ".") {
+			return fmt.Errorf("domainlist: line %d: want <canonical> <kind> <alias>..., got %q", line, scanner.Text())
+		}
+
+		if !slices.Contains(aliasKinds, AliasKind(fields[1])) {
+			return fmt.Errorf("domainlist: line %d: unknown alias kind %q, want one of %v", line, fields[1], aliasKinds)
+		}
+
+		for _, domain := range fields[2:] {
+			aliases = append(aliases, Alias{Domain: domain, Canonical: fields[0], Kind: AliasKind(fields[1])})
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+
+	for _, alias := range aliases {
+		a.Add(alias.Canonical, alias.Kind, alias.Domain)
+	}
+
+	return nil
+}
+
+// LoadFile adds the aliases of the file at path, see Load.
+func (a *Aliases) LoadFile(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	return a.Load(f)
+}
+
+// Add makes domains aliases of canonical, replacing their previous alias if any. Empty domains and canonical itself
+// are skipped.
+func (a *Aliases) Add(canonical string, kind AliasKind, domains ...string) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	canonical = normalize(canonical)
+	if canonical == "" {
+		return
+	}
+
+	for _, domain := range domains {
+		if domain = normalize(domain); domain != "" && domain != canonical {
+			a.aliases[domain] = Alias{Domain: domain, Canonical: canonical, Kind: kind}
+		}
+	}
+}
+
+// Remove removes the aliases of domains.
+func (a *Aliases) Remove(domains ...string) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	for _, domain := range domains {
+		delete(a.aliases, normalize(domain))
+	}
+}
+
+// Lookup returns the alias of domain, if domain is an alias.
+func (a *Aliases) Lookup(domain string) (Alias, bool) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	alias, found := a.aliases[normalize(domain)]
+
+	return alias, found
+}
+
+// Canonical returns the canonical domain of domain, or domain normalized when it isn't an alias.
+func (a *Aliases) Canonical(domain string) string {
+	if alias, found := a.Lookup(domain); found {
+		return alias.Canonical
+	}
+
+	return normalize(domain)
+}
+
+// Len returns the number of aliases.
+func (a *Aliases) Len() int {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	return len(a.aliases)
+}
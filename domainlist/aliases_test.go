@@ -0,0 +1,109 @@
+package domainlist
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAliasesLookup(t *testing.T) {
+	t.Parallel()
+
+	a := NewAliases()
+	a.Add("Acme.com", AliasCountry, "acme.de", "ACME.fr.", "acme.com", " ")
+	a.Add("acme.com", AliasRebrand, "acme-old.com")
+	a.Add("müller.de", AliasBrand, "mueller.de")
+
+	tests := []struct {
+		domain        string
+		expected      Alias
+		expectedFound bool
+	}{
+		{"acme.de", Alias{Domain: "acme.de", Canonical: "acme.com", Kind: AliasCountry}, true},
+		{"acme.fr", Alias{Domain: "acme.fr", Canonical: "acme.com", Kind: AliasCountry}, true},
+		{"ACME-OLD.com.", Alias{Domain: "acme-old.com", Canonical: "acme.com", Kind: AliasRebrand}, true},
+		{"mueller.de", Alias{Domain: "mueller.de", Canonical: "xn--mller-kva.de", Kind: AliasBrand}, true},
+		{"acme.com", Alias{}, false},
+		{"www.acme.de", Alias{}, false},
+		{"", Alias{}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.domain, func(t *testing.T) {
+			t.Parallel()
+
+			alias, found := a.Lookup(tt.domain)
+			require.Equal(t, tt.expectedFound, found)
+			require.Equal(t, tt.expected, alias)
+		})
+	}
+
+	require.Equal(t, 4, a.Len())
+	require.Equal(t, "acme.com", a.Canonical("Acme.DE"))
+	require.Equal(t, "other.com", a.Canonical("Other.com"))
+}
+
+func TestAliasesRemove(t *testing.T) {
+	t.Parallel()
+
+	a := NewAliases()
+	a.Add("acme.com", AliasCountry, "acme.de", "acme.fr")
+	a.Remove("ACME.de", "unknown.com")
+	require.Equal(t, 1, a.Len())
+	require.Equal(t, "acme.de", a.Canonical("acme.de"))
+}
+
+func TestAliasesLoad(t *testing.T) {
+	t.Parallel()
+
+	a := NewAliases()
+	err := a.Load(strings.NewReader("# Comment\n\nacme.com country acme.de acme.fr # trailing comment\nacme.com brand acme.io\n"))
+	require.NoError(t, err)
+	require.Equal(t, 3, a.Len())
+	require.Equal(t, "acme.com", a.Canonical("acme.fr"))
+
+	alias, _ := a.Lookup("acme.io")
+	require.Equal(t, AliasBrand, alias.Kind)
+
+	for _, invalid := range []string{
+		"acme.com country\n", "acme.com acme.de acme.fr\n", "acme.com\n", "acme.com acquisiton widgets.com\n",
+	} {
+		b := NewAliases()
+		require.Error(t, b.Load(strings.NewReader("acme.com brand acme.io\n"+invalid)), invalid)
+		require.Zero(t, b.Len(), invalid)
+	}
+}
+
+func TestAliasesLoadFile(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "aliases.txt")
+	require.NoError(t, os.WriteFile(path, []byte("acme.com acquisition widgets.com\n"), 0o600))
+
+	a := DefaultAliases(CompanyDomainAliases)
+	require.NoError(t, a.LoadFile(path))
+	require.Equal(t, "acme.com", a.Canonical("widgets.com"))
+	require.Equal(t, "amazon.com", a.Canonical("amazon.de"))
+
+	require.Error(t, a.LoadFile(filepath.Join(t.TempDir(), "missing.txt")))
+}
+
+func TestDefaultAliases(t *testing.T) {
+	t.Parallel()
+
+	a := DefaultAliases(CompanyDomainAliases)
+	require.Positive(t, a.Len())
+
+	alias, found := a.Lookup("goo.gle")
+	require.True(t, found)
+	require.Equal(t, Alias{Domain: "goo.gle", Canonical: "google.com", Kind: AliasBrand}, alias)
+
+	// Aliases are independent copies
+	a.Remove("goo.gle")
+	require.Equal(t, "google.com", DefaultAliases(CompanyDomainAliases).Canonical("goo.gle"))
+
+	require.Panics(t, func() { DefaultAliases("unknown") })
+}
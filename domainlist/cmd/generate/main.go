@@ -0,0 +1,104 @@
+// Command generate regenerates an embedded default list of the domainlist package from an upstream text file,
+// with one domain per line. Upstream entries which are public suffixes are skipped.
+//
+// Usage:
+//
+//	go run ./cmd/generate -name url_shorteners -source https://github.com/PeterDaveHello/url-shorteners/raw/master/list
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/surfe/utils/domainlist"
+)
+
+const fetchTimeout = 30 * time.Second
+
+func main() {
+	name := flag.String("name", "", "name of the list, e.g. url_shorteners")
+	source := flag.String("source", "", "URL or path of the upstream list")
+	dir := flag.String("dir", "lists", "directory of the embedded lists")
+	flag.Parse()
+
+	if *name == "" || *source == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	if err := generate(*name, *source, *dir); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func generate(name, source, dir string) error {
+	data, err := read(source)
+	if err != nil {
+		return err
+	}
+
+	// Public suffixes of upstream lists, e.g. "gov.tw", would match every domain registered under them
+	l := domainlist.New()
+	if err := l.Load(bytes.NewReader(data)); errors.Is(err, domainlist.ErrPublicSuffix) {
+		log.Printf("skipped %v", err)
+	} else if err != nil {
+		return fmt.Errorf("read %s: %w", source, err)
+	}
+
+	path := filepath.Join(dir, name+".txt")
+
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	if _, err := fmt.Fprintf(f, "# Source: %s\n", source); err != nil {
+		return err
+	}
+
+	if _, err := l.WriteTo(f); err != nil {
+		return err
+	}
+
+	log.Printf("wrote %d domains to %s", l.Len(), path)
+
+	return f.Close()
+}
+
+// read returns the content of source, fetched when it is a URL.
+func read(source string) ([]byte, error) {
+	if !strings.HasPrefix(source, "http://") && !strings.HasPrefix(source, "https://") {
+		return os.ReadFile(source)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), fetchTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, source, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("fetch %s: %s", source, resp.Status)
+	}
+
+	return io.ReadAll(resp.Body)
+}
@@ -0,0 +1,260 @@
+// Package domainlist provides lists of domains which can be updated without a release: embedded defaults,
+// optionally extended by files or readers, and runtime additions and removals.
+package domainlist
+
+import (
+	"bufio"
+	"embed"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"golang.org/x/net/idna"
+	"golang.org/x/net/publicsuffix"
+)
+
+// Lists with an upstream are regenerated from it below. The others, e.g. education suffixes or social media, have no
+// maintained upstream and are edited by hand in this repository. The "# Source:" header of each list tells which.
+//go:generate go run ./cmd/generate -name url_shorteners -source https://github.com/PeterDaveHello/url-shorteners/raw/master/list
+//go:generate go run ./cmd/generate -name disposable_email_providers -source https://github.com/disposable-email-domains/disposable-email-domains/raw/HEAD/disposable_email_blocklist.conf
+
+// Names of the embedded default lists, see Default.
+const (
+	PersonalEmailProviders   = "personal_email_providers"   // Free webmail, ISPs and disposable inboxes
+	FreeWebmailProviders     = "free_webmail_providers"     // Popular free webmail, e.g. gmail.com
+	DisposableEmailProviders = "disposable_email_providers" // Temporary inboxes, e.g. yopmail.com
+	Education                = "education"                  // Suffixes of educational institutions, e.g. ac.uk
+	Government               = "government"                 // Suffixes of government bodies, e.g. gouv.fr
+	URLShorteners            = "url_shorteners"             // e.g. bit.ly
+	WebsiteBuilders          = "website_builders"           // e.g. wix.com
+	SocialMedia              = "social_media"               // e.g. linkedin.com, and hosting zones, e.g. hatenablog.com
+)
+
+// suffixLists are the default lists which may hold public suffixes, see NewSuffixList.
+var suffixLists = map[string]bool{Education: true, Government: true, SocialMedia: true}
+
+// IDNA converts internationalized domains between their Unicode and ASCII forms per IDNA2008, with the UTS #46
+// mapping, e.g. "MÜLLER.de" to "xn--mller-kva.de". It is the profile of the whole module, so that lists, URLs and
+// emails agree on hosts. Underscores, found in some hostnames, are allowed.
+var IDNA = idna.New(idna.MapForLookup(), idna.BidiRule(), idna.Transitional(false), idna.StrictDomainName(false))
+
+// ErrPublicSuffix is returned for public suffixes added to lists of domains, e.g. "gov.tw" or "za.com", which would
+// match every domain registered under them.
+var ErrPublicSuffix = errors.New("public suffix")
+
+//go:embed lists/*.txt
+var lists embed.FS
+
+// DomainList is a set of domains, safe for concurrent use.
+// Domains are compared case-insensitively, without trailing dot, and internationalized ones in their ASCII form,
+// e.g. "müller.de" matches "xn--mller-kva.de".
+type DomainList struct {
+	mu       sync.RWMutex
+	domains  map[string]struct{}
+	suffixes bool // Public suffixes are allowed
+}
+
+// New returns a list of domains. Public suffixes, including single labels and the shared hosting zones of the
+// private section of the public suffix list, e.g. "gov.tw" or "dyndns.org", are rejected.
+func New(domains ...string) *DomainList {
+	l := &DomainList{domains: make(map[string]struct{}, len(domains))}
+	l.Add(domains...)
+
+	return l
+}
+
+// NewSuffixList returns a list of domain suffixes, which unlike New accepts public suffixes, e.g. "ac.uk".
+func NewSuffixList(suffixes ...string) *DomainList {
+	l := &DomainList{domains: make(map[string]struct{}, len(suffixes)), suffixes: true}
+	l.Add(suffixes...)
+
+	return l
+}
+
+// Default returns a new list loaded from the embedded default list name, e.g. URLShorteners.
+// It panics when there is no such list.
+func Default(name string) *DomainList {
+	f, err := lists.Open("lists/" + name + ".txt")
+	if err != nil {
+		panic("domainlist: unknown default list " + name)
+	}
+	defer f.Close()
+
+	l := New()
+	l.suffixes = suffixLists[name]
+
+	if err := l.Load(f); err != nil {
+		panic("domainlist: failed to load default list " + name + ": " + err.Error())
+	}
+
+	return l
+}
+
+// Load adds the domains read from r, one per line. Blank lines and lines starting with "#" are skipped,
+// only the first field of other lines is read, and wildcards are dropped, e.g. "*.example.com" adds "example.com".
+// Public suffixes are rejected unless the list was created by NewSuffixList: the other domains are added, and an
+// error wrapping ErrPublicSuffix lists the rejected ones.
+func (l *DomainList) Load(r io.Reader) error {
+	var domains, rejected []string
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 || strings.HasPrefix(fields[0], "#") {
+			continue
+		}
+
+		domain := strings.TrimLeft(fields[0], "*.")
+		if !l.suffixes && isPublicSuffix(normalize(domain)) {
+			rejected = append(rejected, domain)
+
+			continue
+		}
+
+		domains = append(domains, domain)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+
+	l.Add(domains...)
+
+	if len(rejected) > 0 {
+		return fmt.Errorf("domainlist: %w: %s", ErrPublicSuffix, strings.Join(rejected, ", "))
+	}
+
+	return nil
+}
+
+// LoadFile adds the domains of the file at path, see Load.
+func (l *DomainList) LoadFile(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	return l.Load(f)
+}
+
+// Add adds domains to the list, skipping empty ones, and public suffixes unless the list was created by NewSuffixList.
+func (l *DomainList) Add(domains ...string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	for _, domain := range domains {
+		if domain = normalize(domain); domain != "" && (l.suffixes || !isPublicSuffix(domain)) {
+			l.domains[domain] = struct{}{}
+		}
+	}
+}
+
+// Remove removes domains from the list. Subdomains of a removed domain which were added themselves are kept.
+func (l *DomainList) Remove(domains ...string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	for _, domain := range domains {
+		delete(l.domains, normalize(domain))
+	}
+}
+
+// Contains reports whether domain itself is in the list.
+func (l *DomainList) Contains(domain string) bool {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	_, found := l.domains[normalize(domain)]
+
+	return found
+}
+
+// Match reports whether domain or one of its parent domains is in the list,
+// e.g. "mail.yahoo.co.uk" matches a list containing "yahoo.co.uk".
+func (l *DomainList) Match(domain string) bool {
+	_, found := l.MatchingDomain(domain)
+
+	return found
+}
+
+// MatchingDomain returns the most specific domain of the list which domain is, or is a subdomain of.
+func (l *DomainList) MatchingDomain(domain string) (string, bool) {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	domain = normalize(domain)
+
+	for domain != "" {
+		if _, found := l.domains[domain]; found {
+			return domain, true
+		}
+
+		_, domain, _ = strings.Cut(domain, ".")
+	}
+
+	return "", false
+}
+
+// Len returns the number of domains in the list.
+func (l *DomainList) Len() int {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	return len(l.domains)
+}
+
+// Domains returns the domains of the list, sorted.
+func (l *DomainList) Domains() []string {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	domains := make([]string, 0, len(l.domains))
+	for domain := range l.domains {
+		domains = append(domains, domain)
+	}
+
+	slices.Sort(domains)
+
+	return domains
+}
+
+// WriteTo writes the domains of the list, sorted, one per line, in the format read by Load.
+func (l *DomainList) WriteTo(w io.Writer) (int64, error) {
+	var written int64
+
+	for _, domain := range l.Domains() {
+		n, err := io.WriteString(w, domain+"\n")
+		written += int64(n)
+
+		if err != nil {
+			return written, err
+		}
+	}
+
+	return written, nil
+}
+
+// isPublicSuffix reports whether domain is a public suffix, e.g. "co.uk", "gov.tw", "dyndns.org" or a single label.
+func isPublicSuffix(domain string) bool {
+	suffix, _ := publicsuffix.PublicSuffix(domain)
+
+	return suffix == domain
+}
+
+func normalize(domain string) string {
+	domain = strings.TrimSuffix(strings.ToLower(strings.TrimSpace(domain)), ".")
+
+	if strings.ContainsFunc(domain, func(r rune) bool { return r >= utf8.RuneSelf }) {
+		if ascii, err := IDNA.ToASCII(domain); err == nil {
+			return ascii
+		}
+	}
+
+	return domain
+}
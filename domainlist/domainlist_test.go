@@ -0,0 +1,151 @@
+package domainlist
+
+import (
+	"bytes"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDomainListMatch(t *testing.T) {
+	t.Parallel()
+
+	l := New("yahoo.co.uk", "Gmail.com.", "mail.acme.com", "müller.de")
+
+	tests := []struct {
+		domain       string
+		wantContains bool
+		wantMatch    bool
+		wantMatching string
+	}{
+		{"yahoo.co.uk", true, true, "yahoo.co.uk"},
+		{"mail.yahoo.co.uk", false, true, "yahoo.co.uk"},
+		{"GMAIL.COM", true, true, "gmail.com"},
+		{"gmail.com.", true, true, "gmail.com"},
+		{"eu.mail.acme.com", false, true, "mail.acme.com"},
+		{"acme.com", false, false, ""},
+		{"co.uk", false, false, ""},
+		{"notyahoo.co.uk", false, false, ""},
+		{"xn--mller-kva.de", true, true, "xn--mller-kva.de"},
+		{"mail.MÜLLER.de", false, true, "xn--mller-kva.de"},
+		{"", false, false, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.domain, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tt.wantContains, l.Contains(tt.domain))
+			require.Equal(t, tt.wantMatch, l.Match(tt.domain))
+
+			matching, found := l.MatchingDomain(tt.domain)
+			require.Equal(t, tt.wantMatch, found)
+			require.Equal(t, tt.wantMatching, matching)
+		})
+	}
+}
+
+func TestDomainListAddRemove(t *testing.T) {
+	t.Parallel()
+
+	l := New("acme.com")
+	l.Add("example.com", " ", "Example.org")
+	require.Equal(t, []string{"acme.com", "example.com", "example.org"}, l.Domains())
+
+	l.Remove("ACME.com", "unknown.com")
+	require.Equal(t, 2, l.Len())
+	require.False(t, l.Match("www.acme.com"))
+}
+
+func TestDomainListLoad(t *testing.T) {
+	t.Parallel()
+
+	l := New()
+	err := l.Load(strings.NewReader("# Comment\n\nbit.ly\n*.t.co\n.goo.gl\ntinyurl.com  # trailing comment\n"))
+	require.NoError(t, err)
+	require.Equal(t, []string{"bit.ly", "goo.gl", "t.co", "tinyurl.com"}, l.Domains())
+
+	var buf bytes.Buffer
+
+	_, err = l.WriteTo(&buf)
+	require.NoError(t, err)
+	require.Equal(t, "bit.ly\ngoo.gl\nt.co\ntinyurl.com\n", buf.String())
+}
+
+func TestDomainListLoadFile(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "extra.txt")
+	require.NoError(t, os.WriteFile(path, []byte("acme.com\n"), 0o600))
+
+	l := Default(WebsiteBuilders)
+	require.False(t, l.Match("acme.com"))
+	require.NoError(t, l.LoadFile(path))
+	require.True(t, l.Match("acme.com"))
+	require.True(t, l.Match("wix.com"))
+
+	require.Error(t, l.LoadFile(filepath.Join(t.TempDir(), "missing.txt")))
+}
+
+func TestDefault(t *testing.T) {
+	t.Parallel()
+
+	for _, name := range []string{
+		PersonalEmailProviders, FreeWebmailProviders, DisposableEmailProviders, Education, Government,
+		URLShorteners, WebsiteBuilders, SocialMedia,
+	} {
+		require.Positive(t, Default(name).Len(), name)
+	}
+
+	require.True(t, Default(PersonalEmailProviders).Match("mail.yahoo.co.uk"))
+
+	// Lists are independent copies
+	l := Default(SocialMedia)
+	l.Remove("linkedin.com")
+	require.True(t, Default(SocialMedia).Contains("linkedin.com"))
+
+	require.Panics(t, func() { Default("unknown") })
+}
+
+func TestDefaultListsWithoutPublicSuffixes(t *testing.T) {
+	t.Parallel()
+
+	names, err := fs.Glob(lists, "lists/*.txt")
+	require.NoError(t, err)
+
+	for _, name := range names {
+		name = strings.TrimSuffix(path.Base(name), ".txt")
+		if suffixLists[name] || name == CompanyDomainAliases {
+			continue
+		}
+
+		data, err := lists.ReadFile("lists/" + name + ".txt")
+		require.NoError(t, err)
+		require.NoError(t, New().Load(bytes.NewReader(data)), name)
+	}
+}
+
+func TestDomainListPublicSuffixes(t *testing.T) {
+	t.Parallel()
+
+	l := New("gov.tw", "za.com", "dyndns.org", "localhost", "acme.za.com", "bit.ly")
+	require.Equal(t, []string{"acme.za.com", "bit.ly"}, l.Domains())
+	require.False(t, l.Match("president.gov.tw"))
+	require.True(t, l.Match("www.acme.za.com"))
+
+	err := l.Load(strings.NewReader("x.se\ntinyurl.com\nnet.ms\n"))
+	require.ErrorIs(t, err, ErrPublicSuffix)
+	require.ErrorContains(t, err, "x.se, net.ms")
+	require.True(t, l.Contains("tinyurl.com"))
+	require.False(t, l.Contains("x.se"))
+
+	suffixes := NewSuffixList("ac.uk", "gouv.fr")
+	require.NoError(t, suffixes.Load(strings.NewReader("edu\n")))
+	require.True(t, suffixes.Match("cs.ox.ac.uk"))
+	require.True(t, suffixes.Match("stanford.edu"))
+}
@@ -1,26 +1,216 @@
 package utils
 
-import "net/mail"
+import (
+	"strings"
+	"unicode"
 
+	"github.com/jpillora/go-tld"
+	"github.com/surfe/utils/domainlist"
+	"golang.org/x/net/publicsuffix"
+)
+
+const (
+	maxEmailLocalPartLength = 64  // RFC 5321, in octets
+	maxHostnameLength       = 253 // RFC 1035, in octets without the trailing dot
+	maxHostnameLabelLength  = 63  // RFC 1035, in octets
+)
+
+// EmailReason is why ValidateEmail rejected an address.
+type EmailReason string
+
+const (
+	EmailReasonEmpty             EmailReason = "empty"
+	EmailReasonMissingAt         EmailReason = "missing_at"
+	EmailReasonEmptyLocalPart    EmailReason = "empty_local_part"
+	EmailReasonLocalPartTooLong  EmailReason = "local_part_too_long"
+	EmailReasonInvalidCharacters EmailReason = "invalid_characters"
+	EmailReasonConsecutiveDots   EmailReason = "consecutive_dots"
+	EmailReasonMisplacedDot      EmailReason = "misplaced_dot" // Local part starting or ending with a dot
+	EmailReasonInvalidDomain     EmailReason = "invalid_domain"
+	EmailReasonUnknownTLD        EmailReason = "unknown_tld" // Missing TLD or TLD not in the public suffix list
+)
+
+// EmailVerdict is the result of ValidateEmail.
+type EmailVerdict struct {
+	Email  string      // Address to store: trimmed, with its domain lowercased and converted to punycode
+	Valid  bool        // Address can be used to send emails
+	Reason EmailReason // Why the address was rejected, empty when valid
+	IDN    bool        // Domain was internationalized and converted to punycode, e.g. "café.fr" -> "xn--caf-dma.fr"
+}
+
+// IsEmailValid reports whether email is a valid bare address, see ValidateEmail.
 func IsEmailValid(email string) bool {
-	_, err := mail.ParseAddress(email)
+	return ValidateEmail(email).Valid
+}
+
+// ValidateEmail checks that email is a bare address, without display name, which can be used to send emails.
+// Quoted local parts (`"john doe"@acme.com`) and internationalized local parts and domains are accepted.
+// Examples:
+//
+//	ValidateEmail("john@acme.com")         -> valid
+//	ValidateEmail("john@café.fr")          -> valid, "john@xn--caf-dma.fr", IDN
+//	ValidateEmail("John <john@acme.com>")  -> invalid_characters
+//	ValidateEmail("john..doe@acme.com")    -> consecutive_dots
+//	ValidateEmail("john@acme.notatld")     -> unknown_tld
+func ValidateEmail(email string) EmailVerdict {
+	email = strings.TrimSpace(email)
+	verdict := EmailVerdict{Email: email}
+
+	if email == "" {
+		verdict.Reason = EmailReasonEmpty
+
+		return verdict
+	}
+
+	at := strings.LastIndexByte(email, '@')
+	if at < 0 {
+		verdict.Reason = EmailReasonMissingAt
+
+		return verdict
+	}
 
-	return err == nil
+	local, domain := email[:at], email[at+1:]
+	if verdict.Reason = validateEmailLocalPart(local); verdict.Reason != "" {
+		return verdict
+	}
+
+	asciiDomain, reason := validateEmailDomain(domain)
+	if reason != "" {
+		verdict.Reason = reason
+
+		return verdict
+	}
+
+	verdict.Email = local + "@" + asciiDomain
+	verdict.Valid = true
+	verdict.IDN = asciiDomain != strings.ToLower(domain)
+
+	return verdict
 }
 
+// PersonalEmailProviders is the list used by IsPersonalEmail, which may be extended or updated at runtime.
+var PersonalEmailProviders = domainlist.Default(domainlist.PersonalEmailProviders)
+
+// IsPersonalEmail reports whether the domain of email is, or is a subdomain of, a personal email provider.
 func IsPersonalEmail(email string) bool {
 	if email == "" {
 		return false
 	}
 
-	domain := DomainFromEmail(email)
-	_, found := personalEmailProvidersDomains[domain]
-
-	return found
+	return IsPersonalEmailDomain(DomainFromEmail(email))
 }
 
+// IsPersonalEmailDomain reports whether domain is, or is a subdomain of, a personal email provider,
+// e.g. "mail.yahoo.co.uk".
 func IsPersonalEmailDomain(domain string) bool {
-	_, found := personalEmailProvidersDomains[domain]
+	return domain != "" && PersonalEmailProviders.Match(domain)
+}
+
+func validateEmailLocalPart(local string) EmailReason {
+	switch {
+	case local == "":
+		return EmailReasonEmptyLocalPart
+	case len(local) > maxEmailLocalPartLength:
+		return EmailReasonLocalPartTooLong
+	case len(local) >= 2 && strings.HasPrefix(local, `"`) && strings.HasSuffix(local, `"`):
+		return validateEmailQuotedLocalPart(local[1 : len(local)-1])
+	case strings.HasPrefix(local, ".") || strings.HasSuffix(local, "."):
+		return EmailReasonMisplacedDot
+	case strings.Contains(local, ".."):
+		return EmailReasonConsecutiveDots
+	}
+
+	for _, r := range local {
+		if r == '.' || isEmailAtext(r) {
+			continue
+		}
+
+		return EmailReasonInvalidCharacters
+	}
+
+	return ""
+}
+
+// validateEmailQuotedLocalPart checks the content of a quoted local part, where only quotes and backslashes
+// must be escaped.
+func validateEmailQuotedLocalPart(quoted string) EmailReason {
+	escaped := false
+
+	for _, r := range quoted {
+		switch {
+		case escaped:
+			escaped = false
+		case r == '\\':
+			escaped = true
+		case r == '"', r < ' ', r == '\x7f':
+			return EmailReasonInvalidCharacters
+		}
+	}
+
+	if escaped {
+		return EmailReasonInvalidCharacters
+	}
+
+	return ""
+}
+
+// isEmailAtext reports whether r may appear unquoted in a local part (RFC 5322 atext, extended by RFC 6531).
+func isEmailAtext(r rune) bool {
+	if r > unicode.MaxASCII {
+		return unicode.IsLetter(r) || unicode.IsNumber(r) || unicode.IsMark(r)
+	}
+
+	return ('a' <= r && r <= 'z') || ('A' <= r && r <= 'Z') || ('0' <= r && r <= '9') ||
+		strings.ContainsRune("!#$%&'*+-/=?^_`{|}~", r)
+}
+
+// validateEmailDomain returns the lowercase ASCII form of domain, with internationalized labels in punycode.
+func validateEmailDomain(domain string) (string, EmailReason) {
+	if strings.Contains(domain, "..") {
+		return "", EmailReasonConsecutiveDots
+	}
+
+	asciiDomain, err := ConvertDomain(strings.ToLower(domain), DomainFormASCII)
+	if err != nil || !isEmailHostname(asciiDomain) {
+		return "", EmailReasonInvalidDomain
+	}
+
+	lastDot := strings.LastIndexByte(asciiDomain, '.')
+	if lastDot < 0 || !isKnownTLD(asciiDomain[lastDot+1:]) {
+		return "", EmailReasonUnknownTLD
+	}
+
+	// Domains which are themselves public suffixes, e.g. "co.uk", can't receive emails
+	if _, err := tld.Parse("http://" + asciiDomain); err != nil {
+		return "", EmailReasonInvalidDomain
+	}
+
+	return asciiDomain, ""
+}
+
+// isEmailHostname reports whether the ASCII domain is a hostname, which email domains must be unlike the hosts of
+// URLs: labels of 1 to 63 letters, digits and hyphens, e.g. not "acme_corp.com", and at most 253 bytes.
+func isEmailHostname(domain string) bool {
+	if domain == "" || len(domain) > maxHostnameLength {
+		return false
+	}
+
+	for label := range strings.SplitSeq(domain, ".") {
+		if label == "" || len(label) > maxHostnameLabelLength || strings.ContainsFunc(label, func(r rune) bool {
+			return (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-'
+		}) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// isKnownTLD reports whether topLevelDomain is a top-level domain of the ICANN section of the public suffix list.
+// It queries x/net/publicsuffix, which go-tld wraps, directly: tld.Parse accepts unknown TLDs through the default "*"
+// rule and reports them like private suffixes, with ICANN false, so it can't tell "acme.notatld" from "acme.github.io".
+func isKnownTLD(topLevelDomain string) bool {
+	suffix, icann := publicsuffix.PublicSuffix(topLevelDomain)
 
-	return found
+	return icann && suffix == topLevelDomain
 }
@@ -0,0 +1,155 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"net/mail"
+	"regexp"
+	"strings"
+)
+
+// reAddressHeaderName matches the field name of address headers, e.g. "To: ".
+var reAddressHeaderName = regexp.MustCompile(`(?i)^\s*(from|to|cc|bcc|reply-to|sender|resent-(from|to|cc|bcc|sender))\s*:`)
+
+// EmailContact is an entry of an address list, see ParseAddressList.
+type EmailContact struct {
+	DisplayName string // Decoded display name, e.g. "Doe, Jane", empty when missing
+	Email       string // Address as returned by ToValidEmail, e.g. "jane@acme.com"
+	FirstName   string // e.g. "Jane", empty when the display name is missing
+	LastName    string // e.g. "Doe"
+	Personal    bool   // Address of a personal email provider, see IsPersonalEmail, corporate otherwise
+}
+
+// ParseAddressList parses an RFC 5322 address list, such as the value of a To or Cc header, into contacts, in order
+// of appearance and without duplicate emails. A leading header name and RFC 2047 encoded display names are accepted.
+// Display names in the "Last, First" form are split accordingly. When some entries are malformed, the contacts of
+// the valid ones are returned along with an error listing the others. Example:
+//
+//	ParseAddressList(`To: "Doe, Jane" <jane@acme.com>, bob@corp.io`)
+//	-> [{DisplayName: "Doe, Jane", Email: "jane@acme.com", FirstName: "Jane", LastName: "Doe"},
+//	    {Email: "bob@corp.io"}]
+func ParseAddressList(header string) ([]EmailContact, error) {
+	header = strings.TrimSpace(reAddressHeaderName.ReplaceAllString(header, ""))
+	if header == "" {
+		return nil, nil
+	}
+
+	var errs []error
+
+	addresses, err := mail.ParseAddressList(header)
+	if err != nil {
+		// Parse entries one by one, to keep the valid ones
+		addresses = nil
+
+		for _, entry := range splitAddressList(header) {
+			address, err := mail.ParseAddress(entry)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("%q: %w", entry, err))
+
+				continue
+			}
+
+			addresses = append(addresses, address)
+		}
+	}
+
+	contacts := make([]EmailContact, 0, len(addresses))
+	seen := make(map[string]bool, len(addresses))
+
+	for _, address := range addresses {
+		email, ok := ToValidEmail(address.Address, DomainFormASCII)
+		if !ok {
+			errs = append(errs, fmt.Errorf("%q: invalid email", address.Address))
+
+			continue
+		}
+
+		if seen[email] {
+			continue
+		}
+
+		seen[email] = true
+
+		contact := EmailContact{
+			DisplayName: strings.TrimSpace(address.Name),
+			Email:       email,
+			Personal:    IsPersonalEmail(email),
+		}
+		contact.FirstName, contact.LastName = contactNameFromDisplayName(contact.DisplayName)
+
+		contacts = append(contacts, contact)
+	}
+
+	if len(errs) > 0 {
+		return contacts, fmt.Errorf("parse address list: %w", errors.Join(errs...))
+	}
+
+	return contacts, nil
+}
+
+// contactNameFromDisplayName splits a display name into first and last names, handling the "Last, First" form.
+// Display names which are email addresses give no name.
+func contactNameFromDisplayName(displayName string) (string, string) {
+	if strings.Contains(displayName, "@") {
+		return "", ""
+	}
+
+	name := SimplifyName(displayName)
+
+	if last, first, ok := strings.Cut(name, ","); ok {
+		first, last = SimplifyName(first), SimplifyName(last)
+		if first != "" && last != "" && !strings.Contains(first, ",") {
+			return first, last
+		}
+
+		name = strings.Join(strings.Fields(strings.ReplaceAll(name, ",", " ")), " ")
+	}
+
+	return FirstAndLastNameFromFullName(name)
+}
+
+// splitAddressList splits an address list on the commas outside quoted strings, comments and angle brackets.
+func splitAddressList(list string) []string {
+	var (
+		entries         []string
+		start           int
+		quoted, escaped bool
+		comment, angle  int
+	)
+
+	for i, r := range list {
+		switch {
+		case escaped:
+			escaped = false
+		case r == '\\':
+			escaped = true
+		case r == '"' && comment == 0:
+			quoted = !quoted
+		case quoted:
+		case r == '(':
+			comment++
+		case r == ')' && comment > 0:
+			comment--
+		case comment > 0:
+		case r == '<':
+			angle++
+		case r == '>' && angle > 0:
+			angle--
+		case r == ',' && angle == 0:
+			entries = append(entries, list[start:i])
+			start = i + 1
+		}
+	}
+
+	entries = append(entries, list[start:])
+
+	nonEmpty := entries[:0]
+
+	for _, entry := range entries {
+		if entry = strings.TrimSpace(entry); entry != "" {
+			nonEmpty = append(nonEmpty, entry)
+		}
+	}
+
+	return nonEmpty
+}
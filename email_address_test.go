@@ -0,0 +1,91 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseAddressList(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		header      string
+		expected    []EmailContact
+		expectedErr bool
+	}{
+		{
+			name:   "Given header with last name first should split names",
+			header: `To: "Doe, Jane" <jane@acme.com>, bob@corp.io`,
+			expected: []EmailContact{
+				{DisplayName: "Doe, Jane", Email: "jane@acme.com", FirstName: "Jane", LastName: "Doe"},
+				{Email: "bob@corp.io"},
+			},
+		},
+		{
+			name:   "Given display names should split names and classify emails",
+			header: `Jane Doe <Jane.Doe@ACME.com>, "John Smith, PhD" <john.smith@gmail.com>, 'Ann Lee' <ann@corp.io>`,
+			expected: []EmailContact{
+				{DisplayName: "Jane Doe", Email: "jane.doe@acme.com", FirstName: "Jane", LastName: "Doe"},
+				{
+					DisplayName: "John Smith, PhD",
+					Email:       "john.smith@gmail.com",
+					FirstName:   "John",
+					LastName:    "Smith",
+					Personal:    true,
+				},
+				{DisplayName: "'Ann Lee'", Email: "ann@corp.io", FirstName: "Ann", LastName: "Lee"},
+			},
+		},
+		{
+			name:   "Given encoded display name should decode it",
+			header: `Cc: =?UTF-8?Q?Ren=C3=A9e_Fran=C3=A7ois?= <renee@acme.fr>`,
+			expected: []EmailContact{
+				{DisplayName: "Renée François", Email: "renee@acme.fr", FirstName: "Renée", LastName: "François"},
+			},
+		},
+		{
+			name:   "Given email as display name should not guess names",
+			header: `"jane@acme.com" <jane@acme.com>`,
+			expected: []EmailContact{
+				{DisplayName: "jane@acme.com", Email: "jane@acme.com"},
+			},
+		},
+		{
+			name:   "Given duplicate emails should keep first",
+			header: `Jane <jane@acme.com>, JANE@acme.com`,
+			expected: []EmailContact{
+				{DisplayName: "Jane", Email: "jane@acme.com", FirstName: "Jane"},
+			},
+		},
+		{
+			name:   "Given malformed entries should return valid ones with error",
+			header: `"Doe, Jane" <jane@acme.com>, not an address, bob@localhost, Bob (Sales, EMEA) <bob@corp.io>`,
+			expected: []EmailContact{
+				{DisplayName: "Doe, Jane", Email: "jane@acme.com", FirstName: "Jane", LastName: "Doe"},
+				{DisplayName: "Bob", Email: "bob@corp.io", FirstName: "Bob"},
+			},
+			expectedErr: true,
+		},
+		{
+			name:   "Given empty header should return nothing",
+			header: "To: ",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			contacts, err := ParseAddressList(tt.header)
+			if tt.expectedErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.Equal(t, tt.expected, contacts)
+		})
+	}
+}
@@ -0,0 +1,102 @@
+package utils
+
+import "strings"
+
+// emailCanonicalRule describes which variants of an address a provider delivers to the same mailbox.
+type emailCanonicalRule struct {
+	domain     string // Domain the provider's domains are aliases of, e.g. "gmail.com" for "googlemail.com"
+	ignoreDots bool   // Dots of the local part are ignored, e.g. "j.doe" and "jdoe"
+	stripTags  bool   // Anything after "+" in the local part is ignored, e.g. "jdoe+news"
+}
+
+var (
+	gmailCanonicalRule = emailCanonicalRule{domain: "gmail.com", ignoreDots: true, stripTags: true}
+	tagsCanonicalRule  = emailCanonicalRule{stripTags: true}
+)
+
+// emailCanonicalRules holds the rules of provider domains.
+var emailCanonicalRules = map[string]emailCanonicalRule{
+	"gmail.com":      gmailCanonicalRule,
+	"googlemail.com": gmailCanonicalRule,
+	"icloud.com":     tagsCanonicalRule,
+	"me.com":         tagsCanonicalRule,
+	"mac.com":        tagsCanonicalRule,
+	"proton.me":      tagsCanonicalRule,
+	"protonmail.com": tagsCanonicalRule,
+	"protonmail.ch":  tagsCanonicalRule,
+	"pm.me":          tagsCanonicalRule,
+	"zoho.com":       tagsCanonicalRule,
+	"zohomail.com":   tagsCanonicalRule,
+}
+
+// emailCanonicalFamilyRules holds the rules of providers with a domain per country, by name,
+// e.g. "hotmail" for "hotmail.com", "hotmail.fr" or "hotmail.co.uk".
+var emailCanonicalFamilyRules = map[string]emailCanonicalRule{
+	"fastmail": tagsCanonicalRule,
+	"hotmail":  tagsCanonicalRule,
+	"live":     tagsCanonicalRule,
+	"msn":      tagsCanonicalRule,
+	"outlook":  tagsCanonicalRule,
+}
+
+// CanonicalEmail returns the canonical form of email, under which all the variants delivered to the same mailbox
+// are equal, and its display form, trimmed with a lowercase domain.
+// Variants are only merged for providers known to ignore them: dots and +tags for Gmail, googlemail.com being
+// gmail.com, and +tags for Outlook, Fastmail, iCloud, Proton and Zoho. Both forms are empty when email is invalid.
+// Examples:
+//
+//	CanonicalEmail("John.Doe+news@GoogleMail.com") -> "johndoe@gmail.com", "John.Doe+news@googlemail.com"
+//	CanonicalEmail("jane+crm@outlook.fr")          -> "jane@outlook.fr", "jane+crm@outlook.fr"
+//	CanonicalEmail("John.Doe+news@acme.com")       -> "john.doe+news@acme.com", "John.Doe+news@acme.com"
+func CanonicalEmail(email string) (string, string) {
+	verdict := ValidateEmail(email)
+	if !verdict.Valid {
+		return "", ""
+	}
+
+	display := verdict.Email
+	at := strings.LastIndexByte(display, '@')
+	local, domain := strings.ToLower(display[:at]), display[at+1:]
+
+	rule, found := emailCanonicalRuleFor(domain)
+	if !found || strings.HasPrefix(local, `"`) {
+		return local + "@" + domain, display
+	}
+
+	canonical := local
+
+	if rule.stripTags {
+		canonical, _, _ = strings.Cut(canonical, "+")
+	}
+
+	if rule.ignoreDots {
+		canonical = strings.ReplaceAll(canonical, ".", "")
+	}
+
+	// Nothing is left of local parts made of a +tag only, e.g. "+news@gmail.com"
+	if canonical == "" {
+		return local + "@" + domain, display
+	}
+
+	if rule.domain != "" {
+		domain = rule.domain
+	}
+
+	return canonical + "@" + domain, display
+}
+
+func emailCanonicalRuleFor(domain string) (emailCanonicalRule, bool) {
+	if rule, found := emailCanonicalRules[domain]; found {
+		return rule, true
+	}
+
+	// Only trust the name of known providers, "live.acme.com" or "outlook.io" may be anyone's
+	if !FreeWebmailProviders.Contains(domain) && !PersonalEmailProviders.Contains(domain) {
+		return emailCanonicalRule{}, false
+	}
+
+	name, _, _ := strings.Cut(domain, ".")
+	rule, found := emailCanonicalFamilyRules[name]
+
+	return rule, found
+}
@@ -0,0 +1,44 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCanonicalEmail(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		email         string
+		wantCanonical string
+		wantDisplay   string
+	}{
+		{"Gmail dots and tag", "John.Doe+news@gmail.com", "johndoe@gmail.com", "John.Doe+news@gmail.com"},
+		{"Googlemail is Gmail", " j.o.h.n.doe@GoogleMail.com ", "johndoe@gmail.com", "j.o.h.n.doe@googlemail.com"},
+		{"Outlook tag", "jane+crm@outlook.com", "jane@outlook.com", "jane+crm@outlook.com"},
+		{"Outlook country domain keeps dots", "Jane.Doe+crm@Outlook.FR", "jane.doe@outlook.fr", "Jane.Doe+crm@outlook.fr"},
+		{"Hotmail country domain", "jane+crm@hotmail.co.uk", "jane@hotmail.co.uk", "jane+crm@hotmail.co.uk"},
+		{"Fastmail tag", "jane+sales@fastmail.fm", "jane@fastmail.fm", "jane+sales@fastmail.fm"},
+		{"iCloud tag", "jane+a@icloud.com", "jane@icloud.com", "jane+a@icloud.com"},
+		{"Yahoo keeps everything", "Jane.Doe+a@yahoo.com", "jane.doe+a@yahoo.com", "Jane.Doe+a@yahoo.com"},
+		{"Corporate keeps everything", "John.Doe+news@acme.com", "john.doe+news@acme.com", "John.Doe+news@acme.com"},
+		{"Corporate domain named like a provider", "jane+crm@live.acme.com", "jane+crm@live.acme.com", "jane+crm@live.acme.com"},
+		{"Tag only local part is kept", "+News@GoogleMail.com", "+news@googlemail.com", "+News@googlemail.com"},
+		{"Outlook tag only local part is kept", "+crm@outlook.com", "+crm@outlook.com", "+crm@outlook.com"},
+		{"Quoted local part is kept", `"John.Doe"@gmail.com`, `"john.doe"@gmail.com`, `"John.Doe"@gmail.com`},
+		{"Internationalized domain", "Jean@Café.fr", "jean@xn--caf-dma.fr", "Jean@xn--caf-dma.fr"},
+		{"Invalid email", "John <john@gmail.com>", "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			canonical, display := CanonicalEmail(tt.email)
+			require.Equal(t, tt.wantCanonical, canonical)
+			require.Equal(t, tt.wantDisplay, display)
+		})
+	}
+}
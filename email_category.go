@@ -0,0 +1,79 @@
+package utils
+
+import (
+	"strings"
+
+	"github.com/surfe/utils/domainlist"
+)
+
+// EmailCategory is the kind of mailbox an email address belongs to.
+type EmailCategory string
+
+const (
+	EmailCategoryInvalid     EmailCategory = "invalid"      // Address rejected by ValidateEmail
+	EmailCategoryDisposable  EmailCategory = "disposable"   // Temporary inbox, e.g. "x@yopmail.com"
+	EmailCategoryRole        EmailCategory = "role"         // Shared mailbox, e.g. "sales@acme.com"
+	EmailCategoryEducation   EmailCategory = "education"    // e.g. "jane@stanford.edu"
+	EmailCategoryGovernment  EmailCategory = "government"   // e.g. "jane@interieur.gouv.fr"
+	EmailCategoryFreeWebmail EmailCategory = "free_webmail" // e.g. "jane@gmail.com"
+	EmailCategoryCorporate   EmailCategory = "corporate"    // Any other address, e.g. "jane@acme.com"
+)
+
+// Lists used by ClassifyEmail, which may be extended or updated at runtime.
+// Education and government lists hold domain suffixes, e.g. "ac.uk" or "gouv.fr".
+var (
+	DisposableEmailProviders = domainlist.Default(domainlist.DisposableEmailProviders)
+	FreeWebmailProviders     = domainlist.Default(domainlist.FreeWebmailProviders)
+	EducationDomains         = domainlist.Default(domainlist.Education)
+	GovernmentDomains        = domainlist.Default(domainlist.Government)
+)
+
+// roleEmailLocalParts are the local parts of role-based addresses, without dots, hyphens or underscores, so that
+// "no-reply", "no.reply" and "no_reply" all match "noreply".
+var roleEmailLocalParts = map[string]bool{
+	"abuse": true, "accounting": true, "accounts": true, "admin": true, "administrator": true, "billing": true,
+	"bonjour": true, "booking": true, "careers": true, "comptabilite": true, "contact": true, "contacto": true,
+	"contactus": true, "customercare": true, "customerservice": true, "donotreply": true, "enquiries": true,
+	"enquiry": true, "facturation": true, "finance": true, "hello": true, "help": true, "helpdesk": true, "hi": true,
+	"hr": true, "info": true, "infos": true, "inquiries": true, "jobs": true, "kontakt": true, "legal": true,
+	"mail": true, "marketing": true, "media": true, "news": true, "newsletter": true, "noreply": true,
+	"notifications": true, "office": true, "partners": true, "postmaster": true, "press": true, "privacy": true,
+	"recrutement": true, "recruiting": true, "recruitment": true, "reservations": true, "sales": true,
+	"security": true, "service": true, "support": true, "team": true, "webmaster": true, "welcome": true,
+}
+
+// ClassifyEmail returns the category of email, each category coming from its own list.
+// When several categories apply, the first of disposable, role, education, government and free webmail wins,
+// e.g. "info@yopmail.com" is disposable and "info@gmail.com" is a role address.
+func ClassifyEmail(email string) EmailCategory {
+	verdict := ValidateEmail(email)
+	if !verdict.Valid {
+		return EmailCategoryInvalid
+	}
+
+	at := strings.LastIndexByte(verdict.Email, '@')
+	local, domain := strings.ToLower(verdict.Email[:at]), verdict.Email[at+1:]
+
+	switch {
+	case DisposableEmailProviders.Match(domain):
+		return EmailCategoryDisposable
+	case isRoleEmailLocalPart(local):
+		return EmailCategoryRole
+	case EducationDomains.Match(domain):
+		return EmailCategoryEducation
+	case GovernmentDomains.Match(domain):
+		return EmailCategoryGovernment
+	case FreeWebmailProviders.Match(domain):
+		return EmailCategoryFreeWebmail
+	default:
+		return EmailCategoryCorporate
+	}
+}
+
+// isRoleEmailLocalPart reports whether local, without its +tag and separators, is a role mailbox.
+func isRoleEmailLocalPart(local string) bool {
+	local, _, _ = strings.Cut(local, "+")
+	local = strings.NewReplacer(".", "", "-", "", "_", "").Replace(local)
+
+	return roleEmailLocalParts[local]
+}
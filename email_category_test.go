@@ -0,0 +1,46 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestClassifyEmail(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		email    string
+		expected EmailCategory
+	}{
+		{"jane.doe@acme.com", EmailCategoryCorporate},
+		{"jane.doe@gmail.com", EmailCategoryFreeWebmail},
+		{"Jane.Doe@GMAIL.com", EmailCategoryFreeWebmail},
+		{"jane@att.net", EmailCategoryFreeWebmail},
+		{"jane@123.com", EmailCategoryCorporate},
+		{"jane@10minutemail.com", EmailCategoryDisposable},
+		{"jane@yopmail.fr", EmailCategoryDisposable},
+		{"info@yopmail.com", EmailCategoryDisposable},
+		{"info@acme.com", EmailCategoryRole},
+		{"Sales@acme.com", EmailCategoryRole},
+		{"no-reply@acme.com", EmailCategoryRole},
+		{"no_reply+alerts@acme.com", EmailCategoryRole},
+		{"info@gmail.com", EmailCategoryRole},
+		{"jane@stanford.edu", EmailCategoryEducation},
+		{"jane@cs.ox.ac.uk", EmailCategoryEducation},
+		{"jane@interieur.gouv.fr", EmailCategoryGovernment},
+		{"jane@cabinetoffice.gov.uk", EmailCategoryGovernment},
+		{"jane@gov.com", EmailCategoryCorporate},
+		{"infosec.team@acme.com", EmailCategoryCorporate},
+		{"John <john@acme.com>", EmailCategoryInvalid},
+		{"", EmailCategoryInvalid},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.email, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tt.expected, ClassifyEmail(tt.email))
+		})
+	}
+}
@@ -0,0 +1,143 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"slices"
+	"strings"
+)
+
+// Resolver looks up the DNS records needed to check email domains. *net.Resolver satisfies it.
+type Resolver interface {
+	LookupMX(ctx context.Context, name string) ([]*net.MX, error)
+	LookupIPAddr(ctx context.Context, host string) ([]net.IPAddr, error)
+}
+
+// EmailDomainStatus tells whether the domain of an email can receive emails.
+type EmailDomainStatus string
+
+const (
+	EmailDomainDeliverable  EmailDomainStatus = "deliverable"    // MX records, or address records used as implicit MX
+	EmailDomainNullMX       EmailDomainStatus = "null_mx"        // Domain explicitly accepts no email (RFC 7505)
+	EmailDomainNoMailServer EmailDomainStatus = "no_mail_server" // Neither MX nor address records
+	EmailDomainInvalid      EmailDomainStatus = "invalid"        // Email rejected by ValidateEmail
+	EmailDomainUnknown      EmailDomainStatus = "unknown"        // DNS lookup failed, the check may be retried
+)
+
+// EmailDomainCheck is the result of CheckEmailDomain.
+type EmailDomainCheck struct {
+	Domain     string            // ASCII domain of the email, e.g. "acme.com"
+	Status     EmailDomainStatus //
+	MXHosts    []string          // Mail servers, by preference, e.g. ["aspmx.l.google.com"]
+	ImplicitMX bool              // Domain has no MX records, its address records receive emails (RFC 5321)
+	Provider   string            // Email hosting provider of the mail servers, e.g. "google", empty when unknown
+	CatchAll   bool              // Provider accepts any recipient, so mailboxes can't be verified over SMTP
+}
+
+// emailHostingProvider is an email hosting provider recognized by the domains of its mail servers.
+type emailHostingProvider struct {
+	name      string
+	mxDomains []string
+	catchAll  bool
+}
+
+// emailHostingProviders are matched in order against the MX hosts of a domain.
+var emailHostingProviders = []emailHostingProvider{
+	{name: "google", mxDomains: []string{"google.com", "googlemail.com"}},
+	{name: "microsoft", mxDomains: []string{"outlook.com", "hotmail.com"}},
+	{name: "zoho", mxDomains: []string{"zoho.com", "zoho.eu", "zoho.in"}},
+	{name: "fastmail", mxDomains: []string{"messagingengine.com"}},
+	{name: "proton", mxDomains: []string{"protonmail.ch"}},
+	{name: "icloud", mxDomains: []string{"icloud.com"}},
+	{name: "yahoo", mxDomains: []string{"yahoodns.net"}, catchAll: true},
+	{name: "proofpoint", mxDomains: []string{"pphosted.com", "ppe-hosted.com"}, catchAll: true},
+	{name: "mimecast", mxDomains: []string{"mimecast.com", "mimecast.co.za"}, catchAll: true},
+	{name: "barracuda", mxDomains: []string{"barracudanetworks.com"}, catchAll: true},
+}
+
+// CheckEmailDomain checks that the domain of email can receive emails, using its MX records, falling back to its
+// A/AAAA records when it has none. It only returns an error, with an EmailDomainUnknown status, when a lookup failed
+// for another reason than the records not existing.
+func CheckEmailDomain(ctx context.Context, email string, resolver Resolver) (EmailDomainCheck, error) {
+	verdict := ValidateEmail(email)
+	if !verdict.Valid {
+		return EmailDomainCheck{Status: EmailDomainInvalid}, nil
+	}
+
+	check := EmailDomainCheck{Domain: verdict.Email[strings.LastIndexByte(verdict.Email, '@')+1:]}
+
+	mxs, err := resolver.LookupMX(ctx, check.Domain)
+	if err != nil && !isDNSNotFound(err) {
+		check.Status = EmailDomainUnknown
+
+		return check, fmt.Errorf("lookup MX of %s: %w", check.Domain, err)
+	}
+
+	if len(mxs) == 0 {
+		return checkImplicitMX(ctx, check, resolver)
+	}
+
+	slices.SortStableFunc(mxs, func(a, b *net.MX) int { return int(a.Pref) - int(b.Pref) })
+
+	for _, mx := range mxs {
+		if host := strings.TrimSuffix(strings.ToLower(mx.Host), "."); host != "" {
+			check.MXHosts = append(check.MXHosts, host)
+		}
+	}
+
+	// A single MX record with an empty host, "." in zone files, is a null MX
+	if len(check.MXHosts) == 0 {
+		check.Status = EmailDomainNullMX
+
+		return check, nil
+	}
+
+	check.Status = EmailDomainDeliverable
+	check.Provider, check.CatchAll = emailHostingProviderOf(check.MXHosts)
+
+	return check, nil
+}
+
+func checkImplicitMX(ctx context.Context, check EmailDomainCheck, resolver Resolver) (EmailDomainCheck, error) {
+	addrs, err := resolver.LookupIPAddr(ctx, check.Domain)
+	if err != nil && !isDNSNotFound(err) {
+		check.Status = EmailDomainUnknown
+
+		return check, fmt.Errorf("lookup addresses of %s: %w", check.Domain, err)
+	}
+
+	if len(addrs) == 0 {
+		check.Status = EmailDomainNoMailServer
+
+		return check, nil
+	}
+
+	check.Status = EmailDomainDeliverable
+	check.ImplicitMX = true
+	check.MXHosts = []string{check.Domain}
+
+	return check, nil
+}
+
+// emailHostingProviderOf returns the provider of the first MX host hosted by a known provider.
+func emailHostingProviderOf(mxHosts []string) (string, bool) {
+	for _, host := range mxHosts {
+		for _, provider := range emailHostingProviders {
+			for _, mxDomain := range provider.mxDomains {
+				if host == mxDomain || strings.HasSuffix(host, "."+mxDomain) {
+					return provider.name, provider.catchAll
+				}
+			}
+		}
+	}
+
+	return "", false
+}
+
+func isDNSNotFound(err error) bool {
+	var dnsErr *net.DNSError
+
+	return errors.As(err, &dnsErr) && dnsErr.IsNotFound
+}
@@ -0,0 +1,149 @@
+package utils
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+var _ Resolver = (*net.Resolver)(nil)
+
+// fakeResolver answers lookups from in-memory records, unknown names are not found.
+type fakeResolver struct {
+	mx    map[string][]*net.MX
+	addrs map[string][]net.IPAddr
+	err   error
+}
+
+func (r fakeResolver) LookupMX(_ context.Context, name string) ([]*net.MX, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	if mxs, ok := r.mx[name]; ok {
+		return mxs, nil
+	}
+
+	return nil, &net.DNSError{Err: "no such host", Name: name, IsNotFound: true}
+}
+
+func (r fakeResolver) LookupIPAddr(_ context.Context, host string) ([]net.IPAddr, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	if addrs, ok := r.addrs[host]; ok {
+		return addrs, nil
+	}
+
+	return nil, &net.DNSError{Err: "no such host", Name: host, IsNotFound: true}
+}
+
+func TestCheckEmailDomain(t *testing.T) {
+	t.Parallel()
+
+	resolver := fakeResolver{
+		mx: map[string][]*net.MX{
+			"acme.com": {
+				{Host: "ALT1.ASPMX.L.GOOGLE.COM.", Pref: 5},
+				{Host: "aspmx.l.google.com.", Pref: 1},
+			},
+			"bank.com":       {{Host: "mxa-001.pphosted.com.", Pref: 10}},
+			"small.com":      {{Host: "mail.small.com.", Pref: 10}},
+			"nomail.com":     {{Host: ".", Pref: 0}},
+			"empty.com":      {},
+			"xn--caf-dma.fr": {{Host: "mx.ovh.net.", Pref: 1}},
+		},
+		addrs: map[string][]net.IPAddr{
+			"implicit.com": {{IP: net.ParseIP("192.0.2.1")}},
+		},
+	}
+
+	tests := []struct {
+		email    string
+		expected EmailDomainCheck
+	}{
+		{
+			email: "jane@acme.com",
+			expected: EmailDomainCheck{
+				Domain:   "acme.com",
+				Status:   EmailDomainDeliverable,
+				MXHosts:  []string{"aspmx.l.google.com", "alt1.aspmx.l.google.com"},
+				Provider: "google",
+			},
+		},
+		{
+			email: "jane@bank.com",
+			expected: EmailDomainCheck{
+				Domain:   "bank.com",
+				Status:   EmailDomainDeliverable,
+				MXHosts:  []string{"mxa-001.pphosted.com"},
+				Provider: "proofpoint",
+				CatchAll: true,
+			},
+		},
+		{
+			email: "jane@small.com",
+			expected: EmailDomainCheck{
+				Domain:  "small.com",
+				Status:  EmailDomainDeliverable,
+				MXHosts: []string{"mail.small.com"},
+			},
+		},
+		{
+			email: "jane@café.fr",
+			expected: EmailDomainCheck{
+				Domain:  "xn--caf-dma.fr",
+				Status:  EmailDomainDeliverable,
+				MXHosts: []string{"mx.ovh.net"},
+			},
+		},
+		{
+			email: "jane@implicit.com",
+			expected: EmailDomainCheck{
+				Domain:     "implicit.com",
+				Status:     EmailDomainDeliverable,
+				MXHosts:    []string{"implicit.com"},
+				ImplicitMX: true,
+			},
+		},
+		{
+			email:    "jane@nomail.com",
+			expected: EmailDomainCheck{Domain: "nomail.com", Status: EmailDomainNullMX},
+		},
+		{
+			email:    "jane@empty.com",
+			expected: EmailDomainCheck{Domain: "empty.com", Status: EmailDomainNoMailServer},
+		},
+		{
+			email:    "jane@dead.com",
+			expected: EmailDomainCheck{Domain: "dead.com", Status: EmailDomainNoMailServer},
+		},
+		{
+			email:    "jane.acme.com",
+			expected: EmailDomainCheck{Status: EmailDomainInvalid},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.email, func(t *testing.T) {
+			t.Parallel()
+
+			check, err := CheckEmailDomain(context.Background(), tt.email, resolver)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, check)
+		})
+	}
+}
+
+func TestCheckEmailDomainLookupError(t *testing.T) {
+	t.Parallel()
+
+	errTimeout := &net.DNSError{Err: "i/o timeout", Name: "acme.com", IsTimeout: true}
+
+	check, err := CheckEmailDomain(context.Background(), "jane@acme.com", fakeResolver{err: errTimeout})
+	require.ErrorIs(t, err, errTimeout)
+	require.Equal(t, EmailDomainCheck{Domain: "acme.com", Status: EmailDomainUnknown}, check)
+}
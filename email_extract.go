@@ -0,0 +1,177 @@
+package utils
+
+import (
+	"encoding/hex"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+	"golang.org/x/net/html"
+)
+
+var (
+	// Candidate addresses, validated with ValidateEmail, e.g. "john.doe@acme.com" or "jörg@café.fr"
+	reEmailCandidate = regexp.MustCompile(`[\p{L}\p{N}._%+\-']+@[\p{L}\p{N}\-]+(?:\.[\p{L}\p{N}\-]+)+`)
+
+	// Obfuscated "@" and ".", e.g. "john [at] acme [dot] com", "john(at)acme.com" or "john {@} acme {.} com"
+	reObfuscatedAt  = regexp.MustCompile(`(?i)\s*[\[({<]\s*(?:at|@)\s*[\])}>]\s*`)
+	reObfuscatedDot = regexp.MustCompile(`(?i)\s*[\[({<]\s*(?:dot|\.)\s*[\])}>]\s*`)
+
+	// Addresses spelled out with words, e.g. "john.doe at acme dot com" or "john AT acme DOT com"
+	reSpelledOutEmail = regexp.MustCompile(`(?i)\b([\p{L}\p{N}._%+\-]+)\s+(at)\s+([\p{L}\p{N}\-]+(?:\s+dot\s+[\p{L}\p{N}\-]+)+)\b`)
+	reSpelledOutDot   = regexp.MustCompile(`(?i)\s+dot\s+`)
+	reMailboxLike     = regexp.MustCompile(`[._+\-\p{N}]`) // e.g. "john.doe" or "jdoe42", unlike words of prose
+)
+
+// skippedHTMLElements hold code or markup rather than text, e.g. `<script>var x = "bot@spam.com"</script>`.
+var skippedHTMLElements = map[string]bool{"noscript": true, "script": true, "style": true, "template": true}
+
+// ExtractEmails returns the valid addresses found in text, lowercased, in order of appearance and without duplicates.
+// It finds plain addresses, including those of mailto: links, and common obfuscations like "john [at] acme [dot] com",
+// "john(at)acme.com" or "john AT acme DOT com". Lowercase "at" and "dot" are only read as such after a local part
+// looking like a mailbox, e.g. "john.doe at acme dot com", so that prose like "we are at acme dot com" is skipped.
+func ExtractEmails(text string) []string {
+	return appendExtractedEmails(nil, text)
+}
+
+// ExtractEmailsFromHTML is like ExtractEmails, but reads the text of an HTML document, the addresses of its mailto:
+// links and those protected by Cloudflare, which are only written in attributes.
+func ExtractEmailsFromHTML(document string) ([]string, error) {
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(document))
+	if err != nil {
+		return nil, err
+	}
+
+	var emails []string
+
+	doc.Find("a[href]").Each(func(_ int, s *goquery.Selection) {
+		href, _ := s.Attr("href")
+		if address, found := cutPrefixFold(strings.TrimSpace(href), "mailto:"); found {
+			emails = appendMailtoEmails(emails, address)
+		}
+	})
+
+	doc.Find("[data-cfemail]").Each(func(_ int, s *goquery.Selection) {
+		encoded, _ := s.Attr("data-cfemail")
+		emails = appendExtractedEmails(emails, decodeCloudflareEmail(encoded))
+	})
+
+	var text strings.Builder
+
+	for _, node := range doc.Nodes {
+		writeHTMLText(&text, node)
+	}
+
+	return appendExtractedEmails(emails, text.String()), nil
+}
+
+func appendExtractedEmails(emails []string, text string) []string {
+	text = reObfuscatedAt.ReplaceAllString(text, "@")
+	text = reObfuscatedDot.ReplaceAllString(text, ".")
+	text = reSpelledOutEmail.ReplaceAllStringFunc(text, func(match string) string {
+		parts := reSpelledOutEmail.FindStringSubmatch(match)
+		if !isSpelledOutEmail(parts[1], parts[2], parts[3]) {
+			return match
+		}
+
+		return parts[1] + "@" + reSpelledOutDot.ReplaceAllString(parts[3], ".")
+	})
+
+	for _, candidate := range reEmailCandidate.FindAllString(text, -1) {
+		emails = appendValidEmail(emails, candidate)
+	}
+
+	return emails
+}
+
+// isSpelledOutEmail reports whether local, at and domain, e.g. "acme dot com", spell out an address: "at" and "dot"
+// are uppercase, or local looks like a mailbox.
+func isSpelledOutEmail(local, at, domain string) bool {
+	if reMailboxLike.MatchString(local) {
+		return true
+	}
+
+	if at != "AT" {
+		return false
+	}
+
+	for _, dot := range reSpelledOutDot.FindAllString(domain, -1) {
+		if strings.TrimSpace(dot) != "DOT" {
+			return false
+		}
+	}
+
+	return true
+}
+
+// appendMailtoEmails appends the addresses of a mailto: link, e.g. "john@acme.com,jane@acme.com?subject=Hi".
+func appendMailtoEmails(emails []string, address string) []string {
+	address, _, _ = strings.Cut(address, "?")
+	if unescaped, err := url.PathUnescape(address); err == nil {
+		address = unescaped
+	}
+
+	for candidate := range strings.SplitSeq(address, ",") {
+		emails = appendValidEmail(emails, candidate)
+	}
+
+	return emails
+}
+
+func appendValidEmail(emails []string, candidate string) []string {
+	candidate = strings.Trim(candidate, ".'-")
+
+	email, valid := ToValidEmail(candidate, DomainFormASCII)
+	if !valid {
+		return emails
+	}
+
+	for _, existing := range emails {
+		if existing == email {
+			return emails
+		}
+	}
+
+	return append(emails, email)
+}
+
+// decodeCloudflareEmail decodes the data-cfemail attribute of Cloudflare's email protection, an hexadecimal key byte
+// followed by the address XORed with it.
+func decodeCloudflareEmail(encoded string) string {
+	decoded, err := hex.DecodeString(encoded)
+	if err != nil || len(decoded) < 2 {
+		return ""
+	}
+
+	for i := 1; i < len(decoded); i++ {
+		decoded[i] ^= decoded[0]
+	}
+
+	return string(decoded[1:])
+}
+
+// writeHTMLText writes the text nodes of node separated by spaces, so that addresses of adjacent elements,
+// e.g. "<p>john@acme.com</p><p>Sales</p>", aren't glued together. Scripts, styles and templates are skipped.
+func writeHTMLText(text *strings.Builder, node *html.Node) {
+	if node.Type == html.ElementNode && skippedHTMLElements[node.Data] {
+		return
+	}
+
+	if node.Type == html.TextNode {
+		text.WriteString(node.Data)
+		text.WriteByte(' ')
+	}
+
+	for child := node.FirstChild; child != nil; child = child.NextSibling {
+		writeHTMLText(text, child)
+	}
+}
+
+func cutPrefixFold(s, prefix string) (string, bool) {
+	if len(s) < len(prefix) || !strings.EqualFold(s[:len(prefix)], prefix) {
+		return s, false
+	}
+
+	return s[len(prefix):], true
+}
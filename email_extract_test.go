@@ -0,0 +1,126 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestExtractEmails(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		text     string
+		expected []string
+	}{
+		{
+			name:     "Given plain addresses should return them deduped",
+			text:     "Contact John.Doe@Acme.com or sales@acme.com. Again: john.doe@acme.com",
+			expected: []string{"john.doe@acme.com", "sales@acme.com"},
+		},
+		{
+			name:     "Given mailto link should return its address",
+			text:     "Write to mailto:jane@acme.io?subject=Hello",
+			expected: []string{"jane@acme.io"},
+		},
+		{
+			name:     "Given bracketed obfuscations should return addresses",
+			text:     "john [at] acme [dot] com, jane(at)acme.com and bob {@} acme {.} co {.} uk",
+			expected: []string{"john@acme.com", "jane@acme.com", "bob@acme.co.uk"},
+		},
+		{
+			name:     "Given spelled out address should return it",
+			text:     "Reach me: john.doe at acme dot com",
+			expected: []string{"john.doe@acme.com"},
+		},
+		{
+			name:     "Given uppercase spelled out address should return it",
+			text:     "Reach me: john AT acme DOT co DOT uk",
+			expected: []string{"john@acme.co.uk"},
+		},
+		{
+			name:     "Given prose with at and dot should not return addresses",
+			text:     "We are at Acme dot com and look at this dot net",
+			expected: nil,
+		},
+		{
+			name:     "Given prose with mixed case at and dot should not return addresses",
+			text:     "Meet me AT acme dot com",
+			expected: nil,
+		},
+		{
+			name:     "Given internationalized domain should return punycode",
+			text:     "Écrivez à jean@café.fr !",
+			expected: []string{"jean@xn--caf-dma.fr"},
+		},
+		{
+			name:     "Given invalid addresses should skip them",
+			text:     "logo@2x.png, john@acme, john..doe@acme.com",
+			expected: nil,
+		},
+		{
+			name:     "Given text without addresses should return nil",
+			text:     "Best regards",
+			expected: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tt.expected, ExtractEmails(tt.text))
+		})
+	}
+}
+
+func TestExtractEmailsFromHTML(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		document string
+		expected []string
+	}{
+		{
+			name: "Given mailto links and text should return both",
+			document: `<html><body>
+				<a href="MAILTO:John.Doe@acme.com,jane%40acme.com?subject=Hi">Email us</a>
+				<p>Support: support [at] acme [dot] com</p>
+			</body></html>`,
+			expected: []string{"john.doe@acme.com", "jane@acme.com", "support@acme.com"},
+		},
+		{
+			name:     "Given adjacent elements should not glue their text",
+			document: `<div><p>sales@acme.com</p><p>Sales team</p></div>`,
+			expected: []string{"sales@acme.com"},
+		},
+		{
+			name:     "Given Cloudflare protected address should decode it",
+			document: `<a href="/cdn-cgi/l/email-protection" class="__cf_email__" data-cfemail="422b2c242d02273a232f322e276c212d2f">[email&#160;protected]</a>`,
+			expected: []string{"info@example.com"},
+		},
+		{
+			name:     "Given styles should ignore them",
+			document: `<style>.x{background:url(logo@2x.png)}</style><p>No address</p>`,
+			expected: nil,
+		},
+		{
+			name: "Given scripts and templates should ignore them",
+			document: `<script>var x = "bot@spam.com"</script><noscript>noscript@spam.com</noscript>` +
+				`<template><p>template@spam.com</p></template><p>Contact sales@acme.com</p>`,
+			expected: []string{"sales@acme.com"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			emails, err := ExtractEmailsFromHTML(tt.document)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, emails)
+		})
+	}
+}
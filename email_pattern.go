@@ -0,0 +1,265 @@
+package utils
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+	"unicode"
+)
+
+// EmailPattern is a template of the local part of company emails, where {first} and {last} are the normalized first
+// and last names and {f} and {l} their initials.
+type EmailPattern string
+
+const (
+	EmailPatternFirstDotLast        EmailPattern = "{first}.{last}" // john.doe
+	EmailPatternFLast               EmailPattern = "{f}{last}"      // jdoe
+	EmailPatternFirst               EmailPattern = "{first}"        // john
+	EmailPatternFirstLast           EmailPattern = "{first}{last}"  // johndoe
+	EmailPatternFDotLast            EmailPattern = "{f}.{last}"     // j.doe
+	EmailPatternFirstUnderscoreLast EmailPattern = "{first}_{last}" // john_doe
+	EmailPatternLastDotFirst        EmailPattern = "{last}.{first}" // doe.john
+	EmailPatternFirstL              EmailPattern = "{first}{l}"     // johnd
+	EmailPatternFirstHyphenLast     EmailPattern = "{first}-{last}" // john-doe
+	EmailPatternLast                EmailPattern = "{last}"         // doe
+	EmailPatternLastFirst           EmailPattern = "{last}{first}"  // doejohn
+	EmailPatternLastF               EmailPattern = "{last}{f}"      // doej
+	EmailPatternFirstDotL           EmailPattern = "{first}.{l}"    // john.d
+	EmailPatternFL                  EmailPattern = "{f}{l}"         // jd
+)
+
+// emailPatternPriors is the share of companies using each pattern, used when nothing is known about a domain.
+var emailPatternPriors = map[EmailPattern]float64{
+	EmailPatternFirstDotLast:        0.40,
+	EmailPatternFLast:               0.16,
+	EmailPatternFirst:               0.10,
+	EmailPatternFirstLast:           0.07,
+	EmailPatternFDotLast:            0.05,
+	EmailPatternFirstUnderscoreLast: 0.04,
+	EmailPatternLastDotFirst:        0.03,
+	EmailPatternFirstL:              0.03,
+	EmailPatternFirstHyphenLast:     0.02,
+	EmailPatternLast:                0.02,
+	EmailPatternLastFirst:           0.02,
+	EmailPatternLastF:               0.02,
+	EmailPatternFirstDotL:           0.02,
+	EmailPatternFL:                  0.02,
+}
+
+// surnameParticles are lowercase particles of compound surnames, e.g. "de la" in "de la Fontaine".
+var surnameParticles = []string{
+	"al", "bin", "da", "das", "de", "del", "della", "den", "der", "des", "di", "do", "dos", "du", "el", "la", "le",
+	"st", "ten", "ter", "van", "von",
+}
+
+// nameLetterReplacer transliterates letters which RemoveAccents keeps, e.g. "ø" in "Søren".
+var nameLetterReplacer = strings.NewReplacer("ß", "ss", "æ", "ae", "œ", "oe", "ø", "o", "ł", "l", "đ", "d", "ð", "d",
+	"þ", "th", "ı", "i")
+
+// EmailPatternExample is a known email of a person of a company.
+type EmailPatternExample struct {
+	FullName string
+	Email    string
+}
+
+// EmailPatternScore is how likely a company uses a pattern.
+type EmailPatternScore struct {
+	Pattern EmailPattern
+	Matches int     // Number of examples the pattern explains
+	Score   float64 // Between 0 and 1, the share of explained examples smoothed by the share of companies using it
+}
+
+// EmailCandidate is a possible email of a person.
+type EmailCandidate struct {
+	Email   string
+	Pattern EmailPattern
+	Score   float64 // Between 0 and 1, higher is more likely
+}
+
+// InferEmailPatterns returns every supported pattern scored against the examples whose email is at domain,
+// the dominant pattern first. Examples may be explained by several patterns, e.g. "john@acme.com" for "John John".
+// Without examples at domain, patterns are ranked by the share of companies using them.
+func InferEmailPatterns(domain string, examples []EmailPatternExample) []EmailPatternScore {
+	domain = strings.ToLower(strings.TrimSpace(domain))
+	matches := make(map[EmailPattern]int)
+	total := 0
+
+	for _, example := range examples {
+		at := strings.LastIndexByte(example.Email, '@')
+		if at < 0 || !strings.EqualFold(strings.TrimSpace(example.Email[at+1:]), domain) {
+			continue
+		}
+
+		local, _, _ := strings.Cut(strings.ToLower(strings.TrimSpace(example.Email[:at])), "+")
+		firstNames, lastNames := emailNameVariants(example.FullName)
+		total++
+
+		for pattern := range emailPatternPriors {
+			if slices.Contains(renderEmailPattern(pattern, firstNames, lastNames), local) {
+				matches[pattern]++
+			}
+		}
+	}
+
+	scores := make([]EmailPatternScore, 0, len(emailPatternPriors))
+	for pattern, prior := range emailPatternPriors {
+		scores = append(scores, EmailPatternScore{
+			Pattern: pattern,
+			Matches: matches[pattern],
+			Score:   (float64(matches[pattern]) + prior) / float64(total+1),
+		})
+	}
+
+	slices.SortFunc(scores, func(a, b EmailPatternScore) int {
+		return cmp.Or(cmp.Compare(b.Score, a.Score), cmp.Compare(a.Pattern, b.Pattern))
+	})
+
+	return scores
+}
+
+// GenerateEmailCandidates returns the possible emails of fullName at domain, most likely first,
+// ranking the patterns by the scores of InferEmailPatterns, or by the share of companies using them when nil.
+// Compound names give several candidates per pattern, the joined names being the most likely,
+// e.g. "jeanpierre.delafontaine" before "jean-pierre.de-la-fontaine" or "jean.fontaine".
+// Example:
+//
+//	GenerateEmailCandidates("Dr. José García", "acme.com", nil) -> jose.garcia@acme.com, jgarcia@acme.com, ...
+func GenerateEmailCandidates(fullName, domain string, patterns []EmailPatternScore) []EmailCandidate {
+	domain = strings.ToLower(strings.TrimSpace(domain))
+	if domain == "" {
+		return nil
+	}
+
+	if patterns == nil {
+		patterns = InferEmailPatterns(domain, nil)
+	}
+
+	firstNames, lastNames := emailNameVariants(fullName)
+	byEmail := make(map[string]EmailCandidate)
+
+	for _, ps := range patterns {
+		for i, local := range renderEmailPattern(ps.Pattern, firstNames, lastNames) {
+			candidate := EmailCandidate{Email: local + "@" + domain, Pattern: ps.Pattern, Score: ps.Score / float64(i+1)}
+			if existing, found := byEmail[candidate.Email]; !found || candidate.Score > existing.Score {
+				byEmail[candidate.Email] = candidate
+			}
+		}
+	}
+
+	candidates := make([]EmailCandidate, 0, len(byEmail))
+	for _, candidate := range byEmail {
+		candidates = append(candidates, candidate)
+	}
+
+	slices.SortFunc(candidates, func(a, b EmailCandidate) int {
+		return cmp.Or(cmp.Compare(b.Score, a.Score), cmp.Compare(a.Email, b.Email))
+	})
+
+	return candidates
+}
+
+// renderEmailPattern renders pattern with every combination of name variants, most likely first.
+// Patterns using a name which is missing render nothing.
+func renderEmailPattern(pattern EmailPattern, firstNames, lastNames []string) []string {
+	usesFirst := strings.Contains(string(pattern), "{first}") || strings.Contains(string(pattern), "{f}")
+	usesLast := strings.Contains(string(pattern), "{last}") || strings.Contains(string(pattern), "{l}")
+
+	if (usesFirst && len(firstNames) == 0) || (usesLast && len(lastNames) == 0) {
+		return nil
+	}
+
+	// Patterns ignoring a name must not render once per variant of it
+	if !usesFirst {
+		firstNames = []string{""}
+	}
+
+	if !usesLast {
+		lastNames = []string{""}
+	}
+
+	var rendered []string
+
+	for _, first := range firstNames {
+		for _, last := range lastNames {
+			local := strings.NewReplacer(
+				"{first}", first, "{last}", last, "{f}", initial(first), "{l}", initial(last),
+			).Replace(string(pattern))
+
+			if !slices.Contains(rendered, local) {
+				rendered = append(rendered, local)
+			}
+		}
+	}
+
+	return rendered
+}
+
+// emailNameVariants returns the ways the first and last names of fullName may be written in emails, most likely
+// first, e.g. "jeanpierre", "jean-pierre" and "jean" for "Jean-Pierre", and "delafontaine", "de-la-fontaine"
+// and "fontaine" for "de la Fontaine".
+func emailNameVariants(fullName string) ([]string, []string) {
+	first, last := FirstAndLastNameFromFullName(SimplifyName(fullName))
+
+	return compoundNameVariants(first), compoundNameVariants(last)
+}
+
+func compoundNameVariants(name string) []string {
+	tokens := strings.FieldsFunc(normalizeEmailName(name), func(r rune) bool { return r == ' ' || r == '-' })
+	if len(tokens) == 0 {
+		return nil
+	}
+
+	var withoutParticles []string
+
+	for _, token := range tokens {
+		if !slices.Contains(surnameParticles, token) {
+			withoutParticles = append(withoutParticles, token)
+		}
+	}
+
+	candidates := []string{strings.Join(tokens, ""), strings.Join(tokens, "-")}
+	if len(withoutParticles) > 0 {
+		candidates = append(candidates,
+			strings.Join(withoutParticles, ""),
+			withoutParticles[0],
+			withoutParticles[len(withoutParticles)-1],
+		)
+	}
+
+	var variants []string
+
+	for _, variant := range candidates {
+		if !slices.Contains(variants, variant) {
+			variants = append(variants, variant)
+		}
+	}
+
+	return variants
+}
+
+// normalizeEmailName lowercases name, removes its accents and keeps letters, digits, spaces and hyphens.
+func normalizeEmailName(name string) string {
+	name = nameLetterReplacer.Replace(strings.ToLower(name))
+	if withoutAccents, err := RemoveAccents(name); err == nil {
+		name = withoutAccents
+	}
+
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r <= unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)), r == '-':
+			return r
+		case unicode.IsSpace(r):
+			return ' '
+		default:
+			return -1
+		}
+	}, name)
+}
+
+func initial(name string) string {
+	if name == "" {
+		return ""
+	}
+
+	return name[:1]
+}
@@ -0,0 +1,138 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestInferEmailPatterns(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		domain      string
+		examples    []EmailPatternExample
+		wantPattern EmailPattern
+		wantMatches int
+	}{
+		{
+			name:   "Given first dot last examples should infer it",
+			domain: "acme.com",
+			examples: []EmailPatternExample{
+				{"John Doe", "john.doe@acme.com"},
+				{"Jane Smith", "Jane.Smith@ACME.com"},
+				{"Zoé Lefèvre", "zoe.lefevre@acme.com"},
+			},
+			wantPattern: EmailPatternFirstDotLast,
+			wantMatches: 3,
+		},
+		{
+			name:   "Given initial and last name examples should infer it despite outliers",
+			domain: "acme.com",
+			examples: []EmailPatternExample{
+				{"John Doe", "jdoe@acme.com"},
+				{"Jane Smith", "jsmith+crm@acme.com"},
+				{"Paul Martin", "paul@acme.com"},
+				{"Anna Müller", "amuller@acme.com"},
+			},
+			wantPattern: EmailPatternFLast,
+			wantMatches: 3,
+		},
+		{
+			name:   "Given compound surname should match its joined form",
+			domain: "acme.fr",
+			examples: []EmailPatternExample{
+				{"Jean-Pierre de la Fontaine", "jeanpierre.delafontaine@acme.fr"},
+				{"Marie Dupont", "marie.dupont@acme.fr"},
+			},
+			wantPattern: EmailPatternFirstDotLast,
+			wantMatches: 2,
+		},
+		{
+			name:   "Given first name examples should infer it",
+			domain: "startup.io",
+			examples: []EmailPatternExample{
+				{"John Doe", "john@startup.io"},
+				{"Jane Smith", "jane@startup.io"},
+				{"Jane Roe", "jane.roe@other.io"},
+			},
+			wantPattern: EmailPatternFirst,
+			wantMatches: 2,
+		},
+		{
+			name:        "Given no examples should rank by priors",
+			domain:      "acme.com",
+			wantPattern: EmailPatternFirstDotLast,
+			wantMatches: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			scores := InferEmailPatterns(tt.domain, tt.examples)
+			require.Len(t, scores, len(emailPatternPriors))
+			require.Equal(t, tt.wantPattern, scores[0].Pattern)
+			require.Equal(t, tt.wantMatches, scores[0].Matches)
+		})
+	}
+}
+
+func TestGenerateEmailCandidates(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		fullName string
+		domain   string
+		examples []EmailPatternExample
+		wantTop  []string
+	}{
+		{
+			name:     "Given no examples should rank by priors",
+			fullName: "Dr. José García",
+			domain:   "Acme.com",
+			wantTop:  []string{"jose.garcia@acme.com", "jgarcia@acme.com", "jose@acme.com"},
+		},
+		{
+			name:     "Given inferred pattern should rank it first",
+			fullName: "Søren Ørsted",
+			domain:   "acme.dk",
+			examples: []EmailPatternExample{{"Lars Hansen", "lhansen@acme.dk"}, {"Mette Holm", "mholm@acme.dk"}},
+			wantTop:  []string{"sorsted@acme.dk", "soren.orsted@acme.dk"},
+		},
+		{
+			name:     "Given compound surname should try joined, hyphenated and single forms",
+			fullName: "José García Márquez",
+			domain:   "acme.es",
+			examples: []EmailPatternExample{{"Juan Pérez", "juan.perez@acme.es"}},
+			wantTop: []string{
+				"jose.garciamarquez@acme.es", "jose.garcia-marquez@acme.es", "jose.garcia@acme.es",
+				"jose.marquez@acme.es",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var patterns []EmailPatternScore
+			if tt.examples != nil {
+				patterns = InferEmailPatterns(tt.domain, tt.examples)
+			}
+
+			candidates := GenerateEmailCandidates(tt.fullName, tt.domain, patterns)
+			require.GreaterOrEqual(t, len(candidates), len(tt.wantTop))
+
+			for i, want := range tt.wantTop {
+				require.Equal(t, want, candidates[i].Email)
+			}
+		})
+	}
+
+	require.Empty(t, GenerateEmailCandidates("John Doe", "", nil))
+	require.Empty(t, GenerateEmailCandidates("", "acme.com", nil))
+}
@@ -0,0 +1,495 @@
+package utils
+
+import (
+	"cmp"
+	"regexp"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/surfe/utils/phoneutils"
+	"github.com/surfe/utils/urls"
+	"golang.org/x/net/html"
+)
+
+const (
+	maxSignatureLines      = 12  // Lines kept after the start of a signature block, the rest being disclaimers
+	maxSignatureLineLength = 100 // Longer lines are prose or disclaimers
+)
+
+// SignaturePhoneLabel is the kind of line announced before a phone number in a signature, e.g. "M:" or "Fax".
+type SignaturePhoneLabel string
+
+const (
+	SignaturePhoneLabelNone   SignaturePhoneLabel = ""
+	SignaturePhoneLabelMobile SignaturePhoneLabel = "mobile"
+	SignaturePhoneLabelWork   SignaturePhoneLabel = "work"
+	SignaturePhoneLabelFax    SignaturePhoneLabel = "fax"
+)
+
+// SignaturePhone is a phone number found in a signature.
+type SignaturePhone struct {
+	Number string               // E.164 number, e.g. "+33612345678"
+	Type   phoneutils.PhoneType // Type of the number, refined by a mobile label when the number is ambiguous
+	Label  SignaturePhoneLabel  // Label written before the number
+}
+
+// EmailSignature is the contact found in the signature block of an email, see ParseEmailSignature.
+type EmailSignature struct {
+	Block     string           // Lines of the signature block, as plain text
+	Name      string           // e.g. "Jane Doe"
+	FirstName string           // e.g. "Jane"
+	LastName  string           // e.g. "Doe"
+	Title     string           // Job title, e.g. "Head of Sales"
+	Company   string           // e.g. "Acme Inc."
+	Email     string           // First email of the block, see ExtractEmails
+	Phones    []SignaturePhone // Without duplicates, in order of appearance
+	Website   string           // Domain of the first website which isn't a public one, e.g. "acme.com"
+	LinkedIn  string           // Cleaned LinkedIn URL, see LinkedinURLCleaner, profiles being preferred to pages
+	Address   string           // Postal address lines joined with ", "
+}
+
+var (
+	// Lines starting the quoted part of replies and forwards
+	reSignatureReplyMarker = regexp.MustCompile(`(?i)^(?:>|on\s.+\swrote:?$|le\s.+\sa\sécrit\s?:?$|am\s.+\sschrieb\s.+:$|` +
+		`-{2,}\s*(?:original message|forwarded message|message d'origine|message transféré)|` +
+		`(?:from|de|von)\s?:\s.+|_{5,}|sent from my\s|envoyé de mon\s)`)
+
+	// RFC 3676 signature delimiter, "-- ", often stripped of its trailing space
+	reSignatureDelimiter = regexp.MustCompile(`^--\s*$`)
+
+	// Sign-offs preceding the signature block, possibly followed by the name, e.g. "Best regards, Jane"
+	reSignatureSignOff = regexp.MustCompile(`(?i)^(?:best(?: regards| wishes)?|kind regards|warm regards|regards|` +
+		`many thanks|thanks(?: again| so much)?|thank you|cheers|sincerely|yours(?: sincerely| truly)?|` +
+		`all the best|cordialement|bien (?:à vous|cordialement)|bonne journée|merci|mit freundlichen grüßen|` +
+		`viele grüße|beste grüße|saludos|un saludo|atentamente|cordiali saluti|met vriendelijke groet(?:en)?)` +
+		`[\s,.!-]*(.*)$`)
+
+	// Lines where disclaimers start
+	reSignatureDisclaimer = regexp.MustCompile(`(?i)^(?:confidential|disclaimer|this (?:e-?mail|message)\s|` +
+		`ce (?:message|courriel)\s|please consider the environment)`)
+
+	// Separators of the fields written on a single line, e.g. "Jane Doe | CEO | Acme"
+	reSignatureSeparator = regexp.MustCompile(`\s+[–—]\s+|\s*[|•·]\s*|\t+|\s{3,}`)
+
+	// Websites with or without scheme, e.g. "https://acme.com/about", "www.acme.com" or "acme.io"
+	reSignatureURL = regexp.MustCompile(`(?i)\b(?:https?://[^\s<>"']+|www\.[^\s<>"']+|` +
+		`[a-z0-9](?:[a-z0-9-]*[a-z0-9])?(?:\.[a-z0-9](?:[a-z0-9-]*[a-z0-9])?)*\.[a-z]{2,}(?:/[^\s<>"']*)?)`)
+
+	// Label ending the text before a phone number, e.g. "Mobile:", "T." or "Fax"
+	reSignaturePhoneLabel = regexp.MustCompile(`(?i)\b(mobile|mob|cell|cellular|portable|handy|m|tel|telephone|` +
+		`phone|ph|t|office|work|direct|d|o|switchboard|standard|fax|f)\b\.?\s*[:.]?\s*[(+]?$`)
+
+	// Postal address lines, with a street number, a street keyword, or a postal code followed by a city
+	reSignatureAddress = regexp.MustCompile(`(?i)^\d+[a-z]?(?:[-/]\d+)?,?\s+\p{L}{3,}|` +
+		`\b(?:street|st\.|avenue|ave\.?|road|rd\.|boulevard|blvd\.?|lane|drive|suite|floor|rue|allée|chemin|` +
+		`platz|straße|strasse|str\.|calle|via|plaza|square)(?:\s|,|$)|` +
+		`\b\d{4,5}\s+\p{Lu}\p{Ll}+|,\s*[A-Z]{2}\s+\d{5}\b|\b[A-Z]{1,2}\d[A-Z\d]?\s+\d[A-Z]{2}\b`)
+
+	// Words of job titles
+	reSignatureTitle = regexp.MustCompile(`(?i)\b(?:ceo|cto|cfo|coo|cmo|cro|cpo|vp|svp|evp|founder|co-founder|` +
+		`cofounder|owner|partner|president|vice president|chairman|officer|director|head|manager|lead|chief|` +
+		`executive|engineer|developer|architect|consultant|specialist|analyst|designer|associate|representative|` +
+		`coordinator|administrator|assistant|recruiter|intern|advisor|sales|marketing|account|business development|` +
+		`product|operations|directeur|directrice|responsable|ingénieur|gérant|gérante|fondateur|fondatrice|` +
+		`chargée?|chef|geschäftsführer(?:in)?|leiter(?:in)?|gerente|directora)\b`)
+
+	// Legal forms ending company names, e.g. "Acme Inc."
+	reSignatureCompany = regexp.MustCompile(`(?i)\b(?:inc|ltd|llc|llp|plc|gmbh|ag|sa|sas|sarl|bv|nv|srl|spa|` +
+		`corp|corporation|company|co|group|limited)\.?$`)
+
+	// Job title and company written together, e.g. "CEO at Acme" or "CEO @ Acme"
+	reSignatureTitleAtCompany = regexp.MustCompile(`(?i)^(.+?)\s+(?:at|@|chez|bei)\s+(.+)$`)
+)
+
+// ParseEmailSignature finds the signature block of the body of an email, plain text or HTML, and extracts the
+// contact written in it. Phone numbers without an international prefix are parsed as numbers of defaultRegion.
+// The block follows a "-- " delimiter or a sign-off like "Best regards", or else is the block of short lines ending
+// the body, which must then hold contact details. Quoted replies and forwards are ignored. It returns false when no
+// signature was found. Example:
+//
+//	Best regards,
+//	Jane Doe
+//	Head of Sales | Acme Inc.
+//	M: +33 6 12 34 56 78
+//	www.acme.com
+//	-> {Name: "Jane Doe", Title: "Head of Sales", Company: "Acme Inc.", Phones: [+33612345678 mobile], Website: "acme.com"}
+func ParseEmailSignature(body, defaultRegion string) (EmailSignature, bool) {
+	if looksLikeHTML(body) {
+		body = htmlToSignatureText(body)
+	}
+
+	block, signOffName := signatureBlock(strings.Split(strings.ReplaceAll(body, "\r\n", "\n"), "\n"), defaultRegion)
+	if len(block) == 0 {
+		return EmailSignature{}, false
+	}
+
+	signature := EmailSignature{Block: strings.Join(block, "\n"), Name: signOffName}
+
+	var texts, address []string
+
+	for _, line := range block {
+		for _, segment := range reSignatureSeparator.Split(line, -1) {
+			if segment = strings.TrimSpace(segment); segment == "" {
+				continue
+			}
+
+			if reSignatureAddress.MatchString(segment) {
+				address = append(address, strings.Trim(segment, " ,"))
+
+				continue
+			}
+
+			if text := signature.extractContactDetails(segment, defaultRegion); text != "" {
+				texts = append(texts, text)
+			}
+		}
+	}
+
+	signature.Address = strings.Join(address, ", ")
+	signature.extractNameTitleAndCompany(texts)
+
+	if signature.Name != "" {
+		signature.FirstName, signature.LastName = FirstAndLastNameFromFullName(signature.Name)
+	}
+
+	found := signature.Name != "" || signature.Title != "" || signature.Company != "" || signature.Email != "" ||
+		len(signature.Phones) > 0 || signature.Website != "" || signature.LinkedIn != "" || signature.Address != ""
+
+	return signature, found
+}
+
+// signatureBlock returns the trimmed non-empty lines of the signature block, and the name written after the sign-off.
+func signatureBlock(lines []string, defaultRegion string) ([]string, string) {
+	for i, line := range lines {
+		lines[i] = strings.TrimSpace(strings.ReplaceAll(line, "\u00a0", " "))
+		if reSignatureReplyMarker.MatchString(lines[i]) {
+			lines = lines[:i]
+
+			break
+		}
+	}
+
+	start, signOffName, fallback := -1, "", false
+
+	for i := len(lines) - 1; i >= 0 && start < 0; i-- {
+		if reSignatureDelimiter.MatchString(lines[i]) {
+			start = i + 1
+		}
+	}
+
+	for i := len(lines) - 1; i >= 0 && start < 0; i-- {
+		// Sign-offs are alone on their line or followed by a name, "Thanks for your help" isn't one
+		match := reSignatureSignOff.FindStringSubmatch(lines[i])
+		if match != nil && (match[1] == "" || looksLikePersonName(match[1])) {
+			start, signOffName = i+1, match[1]
+		}
+	}
+
+	if start < 0 {
+		start, fallback = trailingShortLines(lines), true
+	}
+
+	var block []string
+
+	for _, line := range lines[start:] {
+		if reSignatureDisclaimer.MatchString(line) || len(block) == maxSignatureLines {
+			break
+		}
+
+		if line != "" && len(line) <= maxSignatureLineLength {
+			block = append(block, line)
+		}
+	}
+
+	// Trailing lines of prose aren't a signature when they hold no contact details
+	if fallback && !slices.ContainsFunc(block, func(line string) bool {
+		return hasSignatureContactDetails(line, defaultRegion)
+	}) {
+		return nil, ""
+	}
+
+	return block, signOffName
+}
+
+// trailingShortLines returns the index of the first line of the last paragraph of short lines.
+func trailingShortLines(lines []string) int {
+	start, count := len(lines), 0
+
+	for i := len(lines) - 1; i >= 0; i-- {
+		line := lines[i]
+		if line == "" && count > 1 {
+			break
+		} else if line == "" {
+			continue
+		}
+
+		if len(line) > maxSignatureLineLength || count == maxSignatureLines ||
+			(strings.HasSuffix(line, ".") && len(strings.Fields(line)) > 6) {
+			break
+		}
+
+		start = i
+		count++
+	}
+
+	return start
+}
+
+func hasSignatureContactDetails(line, defaultRegion string) bool {
+	return strings.Contains(line, "@") || reSignatureURL.MatchString(line) ||
+		len(phoneutils.FindNumbers(line, defaultRegion, phoneutils.LeniencyValid)) > 0
+}
+
+// extractContactDetails sets the email, website, LinkedIn URL and phones found in segment, and returns the remaining
+// text, without the labels of phone numbers.
+func (s *EmailSignature) extractContactDetails(segment, defaultRegion string) string {
+	text := reEmailCandidate.ReplaceAllStringFunc(segment, func(candidate string) string {
+		if email, ok := ToValidEmail(candidate, DomainFormASCII); ok && s.Email == "" {
+			s.Email = email
+		}
+
+		return " "
+	})
+
+	// Before phones, which could be found in the digits of URLs
+	text = reSignatureURL.ReplaceAllStringFunc(text, func(rawURL string) string {
+		if !s.addURL(rawURL) {
+			return rawURL
+		}
+
+		return " "
+	})
+
+	var remaining strings.Builder
+
+	end := 0
+
+	for _, match := range phoneutils.FindNumbers(text, defaultRegion, phoneutils.LeniencyValid) {
+		before := text[end:match.Start]
+
+		label := SignaturePhoneLabelNone
+		if loc := reSignaturePhoneLabel.FindStringSubmatchIndex(before); loc != nil {
+			label = signaturePhoneLabel(before[loc[2]:loc[3]])
+			before = before[:loc[0]]
+		}
+
+		s.addPhone(match.Number, label)
+
+		remaining.WriteString(before)
+		remaining.WriteByte(' ')
+
+		end = match.End
+	}
+
+	remaining.WriteString(text[end:])
+
+	return strings.Trim(strings.Join(strings.Fields(remaining.String()), " "), " ,;:-|")
+}
+
+func (s *EmailSignature) addPhone(number phoneutils.PhoneNumber, label SignaturePhoneLabel) {
+	if slices.ContainsFunc(s.Phones, func(phone SignaturePhone) bool { return phone.Number == number.E164 }) {
+		return
+	}
+
+	phoneType := phoneutils.TypeOf(number)
+	if label == SignaturePhoneLabelMobile &&
+		(phoneType == phoneutils.PhoneTypeFixedLineOrMobile || phoneType == phoneutils.PhoneTypeUnknown) {
+		phoneType = phoneutils.PhoneTypeMobile
+	}
+
+	s.Phones = append(s.Phones, SignaturePhone{Number: number.E164, Type: phoneType, Label: label})
+}
+
+// addURL sets the LinkedIn URL or the website from rawURL, and reports whether it was a URL.
+func (s *EmailSignature) addURL(rawURL string) bool {
+	rawURL = strings.TrimRight(rawURL, ".,;:)")
+
+	withScheme := rawURL
+	if !reWebSchema.MatchString(strings.ToLower(withScheme)) {
+		withScheme = "https://" + withScheme
+	}
+
+	if linkedin := LinkedinURLCleaner(withScheme); linkedin != "" {
+		if s.LinkedIn == "" || (!isLinkedInProfileURL(s.LinkedIn) && isLinkedInProfileURL(linkedin)) {
+			s.LinkedIn = linkedin
+		}
+
+		return true
+	}
+
+	domain := DomainFromURL(withScheme, DomainFormASCII)
+	if domain == "" || !isKnownTLD(domain[strings.LastIndexByte(domain, '.')+1:]) {
+		return false
+	}
+
+	if s.Website == "" && !urls.IsPublicDomain(domain) && !urls.IsURLShortenerDomain(domain) &&
+		!IsPersonalEmailDomain(domain) {
+		s.Website = domain
+	}
+
+	return true
+}
+
+// extractNameTitleAndCompany sets the name, job title and company from the text segments of the block, in order.
+func (s *EmailSignature) extractNameTitleAndCompany(texts []string) {
+	var rest []string
+
+	for _, text := range texts {
+		switch {
+		case s.Title == "" && reSignatureTitle.MatchString(text):
+			s.Title = text
+
+			if match := reSignatureTitleAtCompany.FindStringSubmatch(text); match != nil &&
+				reSignatureTitle.MatchString(match[1]) {
+				s.Title, s.Company = match[1], cmp.Or(s.Company, match[2])
+			} else if title, company, ok := strings.Cut(text, ", "); ok && reSignatureCompany.MatchString(company) {
+				s.Title, s.Company = title, cmp.Or(s.Company, company)
+			}
+		case s.Company == "" && reSignatureCompany.MatchString(text):
+			s.Company = text
+
+			// Job title unknown to reSignatureTitle, e.g. "Photographer, Daily Bugle Inc."
+			if title, company, ok := strings.Cut(text, ", "); ok && s.Title == "" && !reSignatureCompany.MatchString(title) {
+				s.Title, s.Company = title, company
+			}
+		case looksLikePersonName(text) && (s.Name == "" || isShorterNameOf(s.Name, text)):
+			s.Name = text
+		case text != s.Name:
+			rest = append(rest, text)
+		}
+	}
+
+	// Without legal form, the company is the first short text after the job title
+	if s.Company == "" && s.Title != "" {
+		for _, text := range rest {
+			if len(strings.Fields(text)) <= 5 && !strings.ContainsAny(text, ".!?") {
+				s.Company = text
+
+				break
+			}
+		}
+	}
+}
+
+func signaturePhoneLabel(label string) SignaturePhoneLabel {
+	switch strings.ToLower(label) {
+	case "mobile", "mob", "cell", "cellular", "portable", "handy", "m":
+		return SignaturePhoneLabelMobile
+	case "fax", "f":
+		return SignaturePhoneLabelFax
+	default:
+		return SignaturePhoneLabelWork
+	}
+}
+
+func isLinkedInProfileURL(linkedinURL string) bool {
+	return strings.Contains(linkedinURL, "/in/") || strings.Contains(linkedinURL, "/pub/")
+}
+
+// looksLikePersonName reports whether s is made of one to four capitalized words of letters, e.g. "Jane" or
+// "Jean-Pierre de La Tour".
+func looksLikePersonName(s string) bool {
+	words := strings.Fields(s)
+	if len(words) == 0 || len(words) > 4 || len(s) > 40 || reSignatureTitle.MatchString(s) ||
+		reSignatureCompany.MatchString(s) {
+		return false
+	}
+
+	capitalized := 0
+
+	for _, word := range words {
+		for _, r := range word {
+			if !unicode.IsLetter(r) && r != '-' && r != '\'' && r != '.' {
+				return false
+			}
+		}
+
+		if first := []rune(word)[0]; unicode.IsUpper(first) {
+			capitalized++
+		}
+	}
+
+	return capitalized >= max(1, len(words)-1) && unicode.IsUpper([]rune(words[0])[0])
+}
+
+// isShorterNameOf reports whether name is the first name of, or otherwise shorter than, fullName, e.g. "Jane" and
+// "Jane Doe", so a signature signed with a first name gets the full name written below.
+func isShorterNameOf(name, fullName string) bool {
+	return len(strings.Fields(name)) < len(strings.Fields(fullName)) && strings.HasPrefix(fullName, name+" ")
+}
+
+// looksLikeHTML reports whether body holds HTML markup rather than plain text.
+func looksLikeHTML(body string) bool {
+	return reHTMLTag.MatchString(body)
+}
+
+var reHTMLTag = regexp.MustCompile(`(?i)<(?:html|body|div|p|br|table|span|a|font|b|strong)\b[^>]*>`)
+
+// htmlSignatureBlockElements start a new line of text.
+var htmlSignatureBlockElements = map[string]bool{
+	"br": true, "p": true, "div": true, "tr": true, "li": true, "table": true, "h1": true, "h2": true, "h3": true,
+	"h4": true, "h5": true, "h6": true, "hr": true, "ul": true, "ol": true,
+}
+
+// htmlToSignatureText converts an HTML body to lines of text, skipping quoted replies and writing the targets of
+// links whose text doesn't show them, e.g. LinkedIn icons.
+func htmlToSignatureText(body string) string {
+	doc, err := html.Parse(strings.NewReader(body))
+	if err != nil {
+		return body
+	}
+
+	var text strings.Builder
+
+	writeSignatureHTMLText(&text, doc)
+
+	return text.String()
+}
+
+func writeSignatureHTMLText(text *strings.Builder, node *html.Node) {
+	if node.Type == html.ElementNode {
+		switch {
+		case node.Data == "style" || node.Data == "script" || node.Data == "head" || node.Data == "blockquote":
+			return
+		case strings.Contains(htmlAttr(node, "class"), "gmail_quote"), htmlAttr(node, "id") == "divRplyFwdMsg":
+			return
+		case htmlSignatureBlockElements[node.Data]:
+			text.WriteByte('\n')
+			defer text.WriteByte('\n')
+		case node.Data == "td":
+			defer text.WriteByte('\t')
+		case node.Data == "a":
+			href := htmlAttr(node, "href")
+			if reWebSchema.MatchString(strings.ToLower(href)) && !reSignatureURL.MatchString(htmlNodeText(node)) {
+				defer text.WriteString(" " + href + " ")
+			}
+		}
+	}
+
+	if node.Type == html.TextNode {
+		text.WriteString(strings.ReplaceAll(node.Data, "\n", " "))
+	}
+
+	for child := node.FirstChild; child != nil; child = child.NextSibling {
+		writeSignatureHTMLText(text, child)
+	}
+}
+
+func htmlAttr(node *html.Node, key string) string {
+	for _, attr := range node.Attr {
+		if attr.Key == key {
+			return attr.Val
+		}
+	}
+
+	return ""
+}
+
+func htmlNodeText(node *html.Node) string {
+	var text strings.Builder
+
+	writeHTMLText(&text, node)
+
+	return text.String()
+}
@@ -0,0 +1,133 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/surfe/utils/phoneutils"
+)
+
+func TestParseEmailSignature(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		body          string
+		region        string
+		expected      EmailSignature
+		expectedFound bool
+	}{
+		{
+			name: "Given sign-off should parse following block",
+			body: "Hi Bob,\n\nThanks for the call today. Let's meet next week.\n\nBest regards,\nJane Doe\n" +
+				"Head of Sales | Acme Inc.\nM: +33 6 12 34 56 78\nT: 01 42 68 53 00\n" +
+				"www.acme.com | linkedin.com/in/janedoe\n10 rue de la Paix, 75002 Paris\n\n" +
+				"This email and any attachments are confidential.",
+			region: "FR",
+			expected: EmailSignature{
+				Block: "Jane Doe\nHead of Sales | Acme Inc.\nM: +33 6 12 34 56 78\nT: 01 42 68 53 00\n" +
+					"www.acme.com | linkedin.com/in/janedoe\n10 rue de la Paix, 75002 Paris",
+				Name:      "Jane Doe",
+				FirstName: "Jane",
+				LastName:  "Doe",
+				Title:     "Head of Sales",
+				Company:   "Acme Inc.",
+				Phones: []SignaturePhone{
+					{Number: "+33612345678", Type: phoneutils.PhoneTypeMobile, Label: SignaturePhoneLabelMobile},
+					{Number: "+33142685300", Type: phoneutils.PhoneTypeFixedLine, Label: SignaturePhoneLabelWork},
+				},
+				Website:  "acme.com",
+				LinkedIn: "https://linkedin.com/in/janedoe",
+				Address:  "10 rue de la Paix, 75002 Paris",
+			},
+			expectedFound: true,
+		},
+		{
+			name: "Given delimiter should parse following block and ignore quoted reply",
+			body: "Hello,\n\nSee attached.\n\n-- \nJohn Smith\nVP Engineering at Globex\nCell: (415) 555-2671\n" +
+				"https://globex.io\n\nOn Mon, Jan 1, 2024 at 10:00 AM Jane <jane@acme.com> wrote:\n> Best,\n> Jane",
+			region: "US",
+			expected: EmailSignature{
+				Block:     "John Smith\nVP Engineering at Globex\nCell: (415) 555-2671\nhttps://globex.io",
+				Name:      "John Smith",
+				FirstName: "John",
+				LastName:  "Smith",
+				Title:     "VP Engineering",
+				Company:   "Globex",
+				Phones: []SignaturePhone{
+					{Number: "+14155552671", Type: phoneutils.PhoneTypeMobile, Label: SignaturePhoneLabelMobile},
+				},
+				Website: "globex.io",
+			},
+			expectedFound: true,
+		},
+		{
+			name: "Given HTML should read link targets and ignore quoted reply",
+			body: `<html><body><p>Hi,</p><p>Sounds good.</p><p>Cheers,<br>Anna</p><table><tr><td>` +
+				`<b>Anna Müller</b><br>Geschäftsführerin<br>Beispiel GmbH<br>Tel. +49 30 1234567<br>` +
+				`Fax +49 30 1234568<br><a href="https://www.beispiel.de">www.beispiel.de</a> ` +
+				`<a href="https://www.linkedin.com/in/anna-mueller-123/"><img src="li.png"></a></td></tr></table>` +
+				`<div class="gmail_quote">Bob wrote:<blockquote>Bob, Corp Inc., +1 212 555 0100</blockquote></div>` +
+				`</body></html>`,
+			region: "DE",
+			expected: EmailSignature{
+				Block: "Anna\nAnna Müller\nGeschäftsführerin\nBeispiel GmbH\nTel. +49 30 1234567\n" +
+					"Fax +49 30 1234568\nwww.beispiel.de  https://www.linkedin.com/in/anna-mueller-123/",
+				Name:      "Anna Müller",
+				FirstName: "Anna",
+				LastName:  "Müller",
+				Title:     "Geschäftsführerin",
+				Company:   "Beispiel GmbH",
+				Phones: []SignaturePhone{
+					{Number: "+49301234567", Type: phoneutils.PhoneTypeFixedLine, Label: SignaturePhoneLabelWork},
+					{Number: "+49301234568", Type: phoneutils.PhoneTypeFixedLine, Label: SignaturePhoneLabelFax},
+				},
+				Website:  "beispiel.de",
+				LinkedIn: "https://www.linkedin.com/in/anna-mueller-123",
+			},
+			expectedFound: true,
+		},
+		{
+			name: "Given trailing contact block without sign-off should parse it",
+			body: "Let me know.\n\nPeter Parker\nPhotographer, Daily Bugle Inc.\npeter@dailybugle.com\n+1 212-555-0199",
+			expected: EmailSignature{
+				Block:     "Peter Parker\nPhotographer, Daily Bugle Inc.\npeter@dailybugle.com\n+1 212-555-0199",
+				Name:      "Peter Parker",
+				FirstName: "Peter",
+				LastName:  "Parker",
+				Title:     "Photographer",
+				Company:   "Daily Bugle Inc.",
+				Email:     "peter@dailybugle.com",
+				Phones: []SignaturePhone{
+					{Number: "+12125550199", Type: phoneutils.PhoneTypeFixedLineOrMobile},
+				},
+			},
+			expectedFound: true,
+		},
+		{
+			name: "Given sign-off with name should keep name",
+			body: "Thanks for your help with the report.\n\nBest, Jane\nAcme | acme.com",
+			expected: EmailSignature{
+				Block:     "Acme | acme.com",
+				Name:      "Jane",
+				FirstName: "Jane",
+				Website:   "acme.com",
+			},
+			expectedFound: true,
+		},
+		{
+			name: "Given body without signature should return false",
+			body: "Ok, thanks.\n\nSent from my iPhone",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			signature, found := ParseEmailSignature(tt.body, tt.region)
+			require.Equal(t, tt.expectedFound, found)
+			require.Equal(t, tt.expected, signature)
+		})
+	}
+}
@@ -0,0 +1,260 @@
+package utils
+
+import (
+	"cmp"
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"net"
+	"net/smtp"
+	"net/textproto"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+)
+
+// SMTPDialer opens connections to SMTP servers. *net.Dialer satisfies it.
+type SMTPDialer interface {
+	DialContext(ctx context.Context, network, address string) (net.Conn, error)
+}
+
+// MailboxStatus tells whether a mailbox exists according to the mail servers of its domain.
+type MailboxStatus string
+
+const (
+	MailboxExists   MailboxStatus = "exists"    // Recipient accepted by a server rejecting unknown recipients
+	MailboxNotFound MailboxStatus = "not_found" // Recipient rejected, or domain receiving no emails
+	MailboxCatchAll MailboxStatus = "catch_all" // Server accepts any recipient, the mailbox may not exist
+	MailboxUnknown  MailboxStatus = "unknown"   // Temporary failure, greylisting, prober blocked, or no SMTPUTF8
+)
+
+// MailboxVerdict is the result of SMTPVerifier.VerifyMailbox.
+type MailboxVerdict struct {
+	Email   string           // Email as validated by ValidateEmail
+	Status  MailboxStatus    //
+	Domain  EmailDomainCheck // DNS check of the domain
+	MXHost  string           // Mail server that answered, empty when it wasn't contacted
+	Code    int              // Reply code deciding the status, e.g. 550, 250 when email was accepted
+	Message string           // Reply text of a rejection, e.g. "5.1.1 User unknown"
+}
+
+// SMTPVerifier verifies that mailboxes exist by starting an email to them, EHLO, MAIL FROM and RCPT TO, without
+// sending any data. Servers accepting the recipient are probed with a random local part to detect catch-all ones.
+// The zero value is ready to use, an SMTPVerifier must not be copied after first use.
+type SMTPVerifier struct {
+	Resolver          Resolver      // Resolves mail servers, net.DefaultResolver when nil
+	Dialer            SMTPDialer    // Connects to mail servers, a net.Dialer when nil
+	HeloName          string        // Name sent with EHLO, "localhost" when empty
+	MailFrom          string        // Envelope sender, the null sender "<>" when empty
+	Port              string        // Port of mail servers, "25" when empty
+	Timeout           time.Duration // Maximum duration of a session with a mail server, 30s when zero
+	MaxConnsPerDomain int           // Maximum concurrent sessions with the mail servers of a domain, 1 when zero
+
+	mutex       sync.Mutex
+	domainSlots map[string]*domainSlots
+}
+
+// domainSlots limits the concurrent sessions with the mail servers of a domain.
+type domainSlots struct {
+	slots chan struct{}
+	users int // Callers holding or waiting for a slot, the domain is forgotten when none is left
+}
+
+// VerifyMailbox checks the domain of email with CheckEmailDomain, then asks its mail servers, by preference, whether
+// they accept email as recipient. Domains of known catch-all providers are not probed. It returns an error, with a
+// MailboxUnknown status, when the DNS lookup failed, ctx is done, or no mail server could be talked to. Reply codes
+// are never errors, they are interpreted in the status.
+func (v *SMTPVerifier) VerifyMailbox(ctx context.Context, email string) (MailboxVerdict, error) {
+	verdict := MailboxVerdict{Email: ValidateEmail(email).Email, Status: MailboxUnknown}
+
+	domain, err := CheckEmailDomain(ctx, email, v.resolver())
+
+	verdict.Domain = domain
+
+	switch {
+	case err != nil:
+		return verdict, err
+	case domain.Status == EmailDomainInvalid, domain.Status == EmailDomainNullMX,
+		domain.Status == EmailDomainNoMailServer:
+		verdict.Status = MailboxNotFound
+
+		return verdict, nil
+	case domain.CatchAll:
+		verdict.Status = MailboxCatchAll
+
+		return verdict, nil
+	}
+
+	release, err := v.acquireDomainSlot(ctx, domain.Domain)
+	if err != nil {
+		return verdict, err
+	}
+	defer release()
+
+	var errs []error
+
+	for _, host := range domain.MXHosts {
+		verdict.MXHost = host
+
+		err = v.probe(ctx, host, &verdict)
+		if err == nil {
+			return verdict, nil
+		}
+
+		errs = append(errs, fmt.Errorf("%s: %w", host, err))
+
+		if ctx.Err() != nil {
+			break
+		}
+	}
+
+	verdict.MXHost, verdict.Code, verdict.Message = "", 0, ""
+
+	return verdict, fmt.Errorf("verify mailbox %s: %w", verdict.Email, errors.Join(errs...))
+}
+
+// probe runs an SMTP session with host and sets the status of verdict from the reply codes. It returns an error when
+// the session could not be run, so the next mail server may be tried.
+func (v *SMTPVerifier) probe(ctx context.Context, host string, verdict *MailboxVerdict) error {
+	timeout := cmp.Or(v.Timeout, 30*time.Second)
+
+	dialCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	conn, err := v.dialer().DialContext(dialCtx, "tcp", net.JoinHostPort(host, cmp.Or(v.Port, "25")))
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	if err = conn.SetDeadline(time.Now().Add(timeout)); err != nil {
+		return err
+	}
+
+	// Unblock pending reads and writes when ctx is done
+	stop := context.AfterFunc(ctx, func() { _ = conn.SetDeadline(time.Unix(1, 0)) })
+	defer stop()
+
+	client, err := smtp.NewClient(conn, host)
+	if err != nil {
+		return interpretSMTPReply(err, verdict, MailboxUnknown)
+	}
+	defer client.Close()
+
+	if err = client.Hello(cmp.Or(v.HeloName, "localhost")); err != nil {
+		return interpretSMTPReply(err, verdict, MailboxUnknown)
+	}
+
+	// Servers without SMTPUTF8 can't be asked about internationalized local parts, e.g. "jörg@acme.de"
+	isASCII := !strings.ContainsFunc(verdict.Email, func(r rune) bool { return r >= utf8.RuneSelf })
+	if smtpUTF8, _ := client.Extension("SMTPUTF8"); !smtpUTF8 && !isASCII {
+		verdict.Status = MailboxUnknown
+
+		return nil
+	}
+
+	if err = client.Mail(v.MailFrom); err != nil {
+		return interpretSMTPReply(err, verdict, MailboxUnknown)
+	}
+
+	if err = client.Rcpt(verdict.Email); err != nil {
+		return interpretSMTPReply(err, verdict, MailboxNotFound)
+	}
+
+	verdict.Status, verdict.Code, verdict.Message = MailboxExists, 250, ""
+
+	// A server accepting a random local part accepts any recipient
+	var reply *textproto.Error
+
+	domain := verdict.Email[strings.LastIndexByte(verdict.Email, '@')+1:]
+
+	switch err = client.Rcpt(strings.ToLower(rand.Text()) + "@" + domain); {
+	case err == nil:
+		verdict.Status = MailboxCatchAll
+	case !errors.As(err, &reply):
+		return err
+	}
+
+	_ = client.Quit()
+
+	return nil
+}
+
+// interpretSMTPReply sets the status of verdict from a reply error, permanent failures (5xx) getting
+// permanentStatus and temporary ones (4xx) MailboxUnknown. It returns err when it isn't a reply.
+func interpretSMTPReply(err error, verdict *MailboxVerdict, permanentStatus MailboxStatus) error {
+	var reply *textproto.Error
+	if !errors.As(err, &reply) {
+		return err
+	}
+
+	verdict.Code, verdict.Message = reply.Code, reply.Msg
+
+	if reply.Code >= 500 {
+		verdict.Status = permanentStatus
+	} else {
+		verdict.Status = MailboxUnknown
+	}
+
+	return nil
+}
+
+// acquireDomainSlot waits for one of the MaxConnsPerDomain slots of domain, and returns the function releasing it.
+func (v *SMTPVerifier) acquireDomainSlot(ctx context.Context, domain string) (func(), error) {
+	v.mutex.Lock()
+
+	if v.domainSlots == nil {
+		v.domainSlots = make(map[string]*domainSlots)
+	}
+
+	slots, ok := v.domainSlots[domain]
+	if !ok {
+		slots = &domainSlots{slots: make(chan struct{}, max(v.MaxConnsPerDomain, 1))}
+		v.domainSlots[domain] = slots
+	}
+
+	slots.users++
+
+	v.mutex.Unlock()
+
+	select {
+	case slots.slots <- struct{}{}:
+		return func() {
+			<-slots.slots
+			v.leaveDomainSlots(domain, slots)
+		}, nil
+	case <-ctx.Done():
+		v.leaveDomainSlots(domain, slots)
+
+		return nil, ctx.Err()
+	}
+}
+
+// leaveDomainSlots forgets the slots of domain once no caller holds or waits for one, so that a long-lived verifier
+// doesn't keep every domain it checked.
+func (v *SMTPVerifier) leaveDomainSlots(domain string, slots *domainSlots) {
+	v.mutex.Lock()
+	defer v.mutex.Unlock()
+
+	if slots.users--; slots.users == 0 {
+		delete(v.domainSlots, domain)
+	}
+}
+
+func (v *SMTPVerifier) resolver() Resolver {
+	if v.Resolver == nil {
+		return net.DefaultResolver
+	}
+
+	return v.Resolver
+}
+
+func (v *SMTPVerifier) dialer() SMTPDialer {
+	if v.Dialer == nil {
+		return &net.Dialer{}
+	}
+
+	return v.Dialer
+}
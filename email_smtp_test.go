@@ -0,0 +1,328 @@
+package utils
+
+import (
+	"cmp"
+	"context"
+	"net"
+	"net/textproto"
+	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// fakeSMTPServer answers SMTP sessions on a local port, accepting the recipients of mailboxes.
+type fakeSMTPServer struct {
+	addr      string
+	greeting  string          // "220 fake ESMTP" when empty
+	mailboxes map[string]bool // Accepted recipients
+	catchAll  bool            // Accept any recipient
+	rcptReply string          // Reply to every RCPT TO when set, e.g. a greylisting one
+	hang      bool            // Never send the greeting
+	delay     time.Duration   // Delay before replying to RCPT TO
+	smtpUTF8  bool            // Advertise the SMTPUTF8 extension
+
+	active    atomic.Int32
+	maxActive atomic.Int32
+}
+
+func startFakeSMTPServer(t *testing.T, server *fakeSMTPServer) *fakeSMTPServer {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	var wg sync.WaitGroup
+
+	t.Cleanup(func() {
+		_ = listener.Close()
+
+		wg.Wait()
+	})
+
+	server.addr = listener.Addr().String()
+
+	wg.Add(1)
+
+	go func() {
+		defer wg.Done()
+
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+
+			wg.Add(1)
+
+			go func() {
+				defer wg.Done()
+
+				server.serve(conn)
+			}()
+		}
+	}()
+
+	return server
+}
+
+func (s *fakeSMTPServer) serve(conn net.Conn) {
+	defer conn.Close()
+
+	active := s.active.Add(1)
+	defer s.active.Add(-1)
+
+	for maxActive := s.maxActive.Load(); active > maxActive; maxActive = s.maxActive.Load() {
+		if s.maxActive.CompareAndSwap(maxActive, active) {
+			break
+		}
+	}
+
+	_ = conn.SetDeadline(time.Now().Add(5 * time.Second))
+
+	if s.hang {
+		_, _ = conn.Read(make([]byte, 1))
+
+		return
+	}
+
+	tp := textproto.NewConn(conn)
+	reply := func(line string) { _ = tp.PrintfLine("%s", line) }
+
+	greeting := cmp.Or(s.greeting, "220 fake ESMTP")
+	if reply(greeting); !strings.HasPrefix(greeting, "220") {
+		return
+	}
+
+	for {
+		line, err := tp.ReadLine()
+		if err != nil {
+			return
+		}
+
+		command, arg, _ := strings.Cut(line, " ")
+
+		switch strings.ToUpper(command) {
+		case "EHLO", "HELO":
+			if s.smtpUTF8 {
+				reply("250-fake")
+				reply("250 SMTPUTF8")
+			} else {
+				reply("250 fake")
+			}
+		case "MAIL":
+			reply("250 2.1.0 OK")
+		case "RCPT":
+			time.Sleep(s.delay)
+
+			recipient := strings.Trim(strings.TrimPrefix(strings.ToUpper(arg), "TO:"), "<>")
+
+			switch {
+			case s.rcptReply != "":
+				reply(s.rcptReply)
+			case s.catchAll || s.mailboxes[strings.ToLower(recipient)]:
+				reply("250 2.1.5 OK")
+			default:
+				reply("550 5.1.1 User unknown")
+			}
+		case "QUIT":
+			reply("221 2.0.0 Bye")
+
+			return
+		default:
+			reply("502 5.5.2 Command not recognized")
+		}
+	}
+}
+
+// fakeSMTPDialer connects to the fake server of each "host:port", refusing unknown ones.
+type fakeSMTPDialer map[string]*fakeSMTPServer
+
+func (d fakeSMTPDialer) DialContext(ctx context.Context, network, address string) (net.Conn, error) {
+	server, ok := d[address]
+	if !ok {
+		return nil, &net.OpError{Op: "dial", Net: network, Err: syscall.ECONNREFUSED}
+	}
+
+	return (&net.Dialer{}).DialContext(ctx, network, server.addr)
+}
+
+func TestSMTPVerifierVerifyMailbox(t *testing.T) {
+	t.Parallel()
+
+	acme := startFakeSMTPServer(t, &fakeSMTPServer{
+		mailboxes: map[string]bool{"jane@acme.com": true, "jörg@acme.com": true},
+	})
+	smtpUTF8 := startFakeSMTPServer(t, &fakeSMTPServer{mailboxes: map[string]bool{"jörg@utf8.com": true}, smtpUTF8: true})
+	catchAll := startFakeSMTPServer(t, &fakeSMTPServer{catchAll: true})
+	greylisting := startFakeSMTPServer(t, &fakeSMTPServer{rcptReply: "451 4.7.1 Greylisted, try again later"})
+	blocking := startFakeSMTPServer(t, &fakeSMTPServer{greeting: "554 5.7.1 Client host blocked"})
+
+	verifier := &SMTPVerifier{
+		Resolver: fakeResolver{mx: map[string][]*net.MX{
+			"acme.com":     {{Host: "mx.acme.com.", Pref: 10}},
+			"utf8.com":     {{Host: "mx.utf8.com.", Pref: 10}},
+			"backup.com":   {{Host: "mx1.backup.com.", Pref: 10}, {Host: "mx2.backup.com.", Pref: 20}},
+			"catchall.com": {{Host: "mx.catchall.com.", Pref: 10}},
+			"grey.com":     {{Host: "mx.grey.com.", Pref: 10}},
+			"blocked.com":  {{Host: "mx.blocked.com.", Pref: 10}},
+			"bank.com":     {{Host: "mxa-001.pphosted.com.", Pref: 10}},
+			"nomail.com":   {{Host: ".", Pref: 0}},
+		}},
+		Dialer: fakeSMTPDialer{
+			"mx.acme.com:25":     acme,
+			"mx.utf8.com:25":     smtpUTF8,
+			"mx2.backup.com:25":  acme,
+			"mx.catchall.com:25": catchAll,
+			"mx.grey.com:25":     greylisting,
+			"mx.blocked.com:25":  blocking,
+		},
+	}
+
+	tests := []struct {
+		email   string
+		status  MailboxStatus
+		mxHost  string
+		code    int
+		message string
+	}{
+		{email: "jane@acme.com", status: MailboxExists, mxHost: "mx.acme.com", code: 250},
+		{email: "Jane@ACME.com", status: MailboxExists, mxHost: "mx.acme.com", code: 250},
+		{
+			email:   "john@acme.com",
+			status:  MailboxNotFound,
+			mxHost:  "mx.acme.com",
+			code:    550,
+			message: "5.1.1 User unknown",
+		},
+		{
+			email:   "jane@backup.com",
+			status:  MailboxNotFound,
+			mxHost:  "mx2.backup.com",
+			code:    550,
+			message: "5.1.1 User unknown",
+		},
+		{email: "jane@catchall.com", status: MailboxCatchAll, mxHost: "mx.catchall.com", code: 250},
+		{email: "jörg@utf8.com", status: MailboxExists, mxHost: "mx.utf8.com", code: 250},
+		{email: "jörg@acme.com", status: MailboxUnknown, mxHost: "mx.acme.com"},
+		{
+			email:   "jane@grey.com",
+			status:  MailboxUnknown,
+			mxHost:  "mx.grey.com",
+			code:    451,
+			message: "4.7.1 Greylisted, try again later",
+		},
+		{
+			email:   "jane@blocked.com",
+			status:  MailboxUnknown,
+			mxHost:  "mx.blocked.com",
+			code:    554,
+			message: "5.7.1 Client host blocked",
+		},
+		{email: "jane@bank.com", status: MailboxCatchAll},
+		{email: "jane@nomail.com", status: MailboxNotFound},
+		{email: "jane@dead.com", status: MailboxNotFound},
+		{email: "jane.acme.com", status: MailboxNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.email, func(t *testing.T) {
+			t.Parallel()
+
+			verdict, err := verifier.VerifyMailbox(context.Background(), tt.email)
+			require.NoError(t, err)
+			require.Equal(t, tt.status, verdict.Status)
+			require.Equal(t, tt.mxHost, verdict.MXHost)
+			require.Equal(t, tt.code, verdict.Code)
+			require.Equal(t, tt.message, verdict.Message)
+		})
+	}
+}
+
+func TestSMTPVerifierUnreachable(t *testing.T) {
+	t.Parallel()
+
+	hanging := startFakeSMTPServer(t, &fakeSMTPServer{hang: true})
+	resolver := fakeResolver{mx: map[string][]*net.MX{
+		"acme.com": {{Host: "mx1.acme.com.", Pref: 10}, {Host: "mx2.acme.com.", Pref: 20}},
+	}}
+
+	t.Run("Given unreachable servers should return error", func(t *testing.T) {
+		t.Parallel()
+
+		verifier := &SMTPVerifier{Resolver: resolver, Dialer: fakeSMTPDialer{}}
+
+		verdict, err := verifier.VerifyMailbox(context.Background(), "jane@acme.com")
+		require.ErrorIs(t, err, syscall.ECONNREFUSED)
+		require.ErrorContains(t, err, "mx2.acme.com")
+		require.Equal(t, MailboxUnknown, verdict.Status)
+		require.Empty(t, verdict.MXHost)
+	})
+
+	t.Run("Given silent server should time out", func(t *testing.T) {
+		t.Parallel()
+
+		verifier := &SMTPVerifier{
+			Resolver: resolver,
+			Dialer:   fakeSMTPDialer{"mx1.acme.com:25": hanging},
+			Timeout:  50 * time.Millisecond,
+		}
+
+		verdict, err := verifier.VerifyMailbox(context.Background(), "jane@acme.com")
+		require.ErrorIs(t, err, os.ErrDeadlineExceeded)
+		require.Equal(t, MailboxUnknown, verdict.Status)
+	})
+
+	t.Run("Given cancelled context should stop session", func(t *testing.T) {
+		t.Parallel()
+
+		verifier := &SMTPVerifier{Resolver: resolver, Dialer: fakeSMTPDialer{"mx1.acme.com:25": hanging}}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		start := time.Now()
+
+		verdict, err := verifier.VerifyMailbox(ctx, "jane@acme.com")
+		require.Error(t, err)
+		require.Less(t, time.Since(start), 5*time.Second)
+		require.Equal(t, MailboxUnknown, verdict.Status)
+	})
+}
+
+func TestSMTPVerifierMaxConnsPerDomain(t *testing.T) {
+	t.Parallel()
+
+	server := startFakeSMTPServer(t, &fakeSMTPServer{catchAll: true, delay: 20 * time.Millisecond})
+	verifier := &SMTPVerifier{
+		Resolver: fakeResolver{mx: map[string][]*net.MX{
+			"acme.com": {{Host: "mx.acme.com.", Pref: 10}},
+		}},
+		Dialer:            fakeSMTPDialer{"mx.acme.com:25": server},
+		MaxConnsPerDomain: 2,
+	}
+
+	var wg sync.WaitGroup
+
+	for range 6 {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			verdict, err := verifier.VerifyMailbox(context.Background(), "jane@acme.com")
+			require.NoError(t, err)
+			require.Equal(t, MailboxCatchAll, verdict.Status)
+		}()
+	}
+
+	wg.Wait()
+
+	require.Equal(t, int32(2), server.maxActive.Load())
+	require.Empty(t, verifier.domainSlots)
+}
@@ -1,6 +1,7 @@
 package utils
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -14,7 +15,10 @@ func TestIsPersonalEmail(t *testing.T) {
 		expected bool
 	}{
 		{"test@gmail.com", true},
+		{"test@mail.yahoo.co.uk", true},
 		{"user@surfe.com", false},
+		{"john@acme.za.com", false},
+		{"john@acme.dyndns.org", false},
 		{"", false},
 	}
 
@@ -47,3 +51,53 @@ func TestIsPersonalEmailDomain(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateEmail(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		email     string
+		wantEmail string
+		wantValid bool
+		reason    EmailReason
+		wantIDN   bool
+	}{
+		{"Valid email", "john.doe@acme.com", "john.doe@acme.com", true, "", false},
+		{"Trimmed with lowercased domain", " John.Doe@ACME.com ", "John.Doe@acme.com", true, "", false},
+		{"Plus tag and subdomain", "john+news@mail.acme.co.uk", "john+news@mail.acme.co.uk", true, "", false},
+		{"Quoted local part", `"john doe"@acme.com`, `"john doe"@acme.com`, true, "", false},
+		{"Quoted local part with escaped quote", `"john\"doe"@acme.com`, `"john\"doe"@acme.com`, true, "", false},
+		{"Internationalized domain", "jean@café.fr", "jean@xn--caf-dma.fr", true, "", true},
+		{"Internationalized local part", "jörg@acme.de", "jörg@acme.de", true, "", false},
+		{"Punycode domain", "jean@xn--caf-dma.fr", "jean@xn--caf-dma.fr", true, "", false},
+		{"Private suffix domain", "john@acme.github.io", "john@acme.github.io", true, "", false},
+		{"Empty", " ", "", false, EmailReasonEmpty, false},
+		{"Missing @", "john.acme.com", "john.acme.com", false, EmailReasonMissingAt, false},
+		{"Empty local part", "@acme.com", "@acme.com", false, EmailReasonEmptyLocalPart, false},
+		{"Local part too long", strings.Repeat("a", 65) + "@acme.com", strings.Repeat("a", 65) + "@acme.com", false, EmailReasonLocalPartTooLong, false},
+		{"Display name", "John <john@acme.com>", "John <john@acme.com>", false, EmailReasonInvalidCharacters, false},
+		{"Space in local part", "john doe@acme.com", "john doe@acme.com", false, EmailReasonInvalidCharacters, false},
+		{"Unescaped quote in quoted local part", `"john"doe"@acme.com`, `"john"doe"@acme.com`, false, EmailReasonInvalidCharacters, false},
+		{"Consecutive dots in local part", "john..doe@acme.com", "john..doe@acme.com", false, EmailReasonConsecutiveDots, false},
+		{"Consecutive dots in domain", "john@acme..com", "john@acme..com", false, EmailReasonConsecutiveDots, false},
+		{"Leading dot", ".john@acme.com", ".john@acme.com", false, EmailReasonMisplacedDot, false},
+		{"Invalid domain", "john@acme_corp.com", "john@acme_corp.com", false, EmailReasonInvalidDomain, false},
+		{"Public suffix domain", "john@co.uk", "john@co.uk", false, EmailReasonInvalidDomain, false},
+		{"Missing TLD", "demo-pd@leadjet", "demo-pd@leadjet", false, EmailReasonUnknownTLD, false},
+		{"Unknown TLD", "john@acme.notatld", "john@acme.notatld", false, EmailReasonUnknownTLD, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			verdict := ValidateEmail(tt.email)
+			require.Equal(t, tt.wantEmail, verdict.Email)
+			require.Equal(t, tt.wantValid, verdict.Valid)
+			require.Equal(t, tt.reason, verdict.Reason)
+			require.Equal(t, tt.wantIDN, verdict.IDN)
+			require.Equal(t, tt.wantValid, IsEmailValid(tt.email))
+		})
+	}
+}
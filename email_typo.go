@@ -0,0 +1,212 @@
+package utils
+
+import (
+	"strings"
+)
+
+const (
+	maxEmailTypoDistance      = 2.0
+	maxShortEmailTypoDistance = 1.0 // For domains with short names, e.g. "aol.com", where small typos give other domains
+	minEmailTypoNameLength    = 6
+
+	emailTypoCost          = 1.0
+	emailCloseTypoCost     = 0.5  // Adjacent keys, swapped letters and doubled or missed double letters
+	emailTLDTypoCost       = 0.25 // Known typo of a TLD which doesn't exist, e.g. ".con"
+	emailValidTLDTypoCost  = 0.5  // Known typo giving another existing TLD, e.g. ".cm" for ".com"
+	emailAmbiguityPenalty  = 0.5  // Confidence is multiplied by it when several domains are as close
+	emailTypoConfidenceMax = 3.0  // Distance giving a zero confidence
+)
+
+// emailTLDTypos maps common typos of TLDs to the intended TLD.
+var emailTLDTypos = map[string]string{
+	"con": "com", "cmo": "com", "cpm": "com", "vom": "com", "xom": "com", "ocm": "com", "comm": "com", "coom": "com",
+	"cm": "com", "om": "com", "co": "com",
+	"nte": "net", "ne": "net", "nett": "net", "met": "net",
+	"ogr": "org", "orgg": "org", "prg": "org",
+	"frr": "fr", "ffr": "fr",
+}
+
+// qwertyRows is the QWERTY layout used to tell typos of adjacent keys, each row shifted right by half a key.
+var qwertyRows = []string{"qwertyuiop", "asdfghjkl", "zxcvbnm"}
+
+// EmailSuggestion is a likely correction of a mistyped email.
+type EmailSuggestion struct {
+	Email      string  // Suggested address, e.g. "john@gmail.com"
+	Confidence float64 // Between 0 and 1, close to 1 when the typo is obvious, e.g. "john@gmail.con"
+}
+
+// SuggestEmailCorrection proposes the most likely intended address of an email with a mistyped domain,
+// comparing its domain to popular free webmail domains with a keyboard-aware edit distance and fixing common TLD
+// typos. It returns false when the domain looks right or nothing close was found.
+// Examples:
+//
+//	SuggestEmailCorrection("john@gmial.com")  -> "john@gmail.com", 0.83
+//	SuggestEmailCorrection("jane@outlok.fr")  -> "jane@outlook.fr", 0.83
+//	SuggestEmailCorrection("john@acme.con")   -> "john@acme.com", 0.92
+//	SuggestEmailCorrection("john@gmail.com")  -> false
+func SuggestEmailCorrection(email string) (EmailSuggestion, bool) {
+	email = strings.TrimSpace(email)
+
+	at := strings.LastIndexByte(email, '@')
+	if at <= 0 {
+		return EmailSuggestion{}, false
+	}
+
+	local, domain := email[:at], strings.ToLower(email[at+1:])
+	if domain == "" || FreeWebmailProviders.Contains(domain) {
+		return EmailSuggestion{}, false
+	}
+
+	suggested, distance, ambiguous := closestEmailDomain(domain)
+	if suggested == "" {
+		return EmailSuggestion{}, false
+	}
+
+	confidence := max(0, 1-distance/emailTypoConfidenceMax)
+	if ambiguous {
+		confidence *= emailAmbiguityPenalty
+	}
+
+	return EmailSuggestion{Email: local + "@" + suggested, Confidence: confidence}, true
+}
+
+// closestEmailDomain returns the popular domain closest to domain, or domain with its TLD typo fixed.
+func closestEmailDomain(domain string) (string, float64, bool) {
+	candidates := map[string]float64{domain: 0}
+
+	name, topLevelDomain, found := cutLast(domain, ".")
+	if fixed, isTypo := emailTLDTypos[topLevelDomain]; found && isTypo {
+		cost := emailTLDTypoCost
+		if isKnownTLD(topLevelDomain) {
+			cost = emailValidTLDTypoCost
+		}
+
+		candidates[name+"."+fixed] = cost
+	}
+
+	best, bestDistance, ambiguous := "", maxEmailTypoDistance+emailValidTLDTypoCost, false
+
+	for candidate, cost := range candidates {
+		for _, popular := range FreeWebmailProviders.Domains() {
+			distance := cost + emailTypoDistance(candidate, popular)
+			if distance > cost+maxEmailTypoDistanceFor(popular) {
+				continue
+			}
+
+			switch {
+			case distance < bestDistance:
+				best, bestDistance, ambiguous = popular, distance, false
+			case distance == bestDistance && popular != best:
+				// Keep the result deterministic whatever the iteration order
+				best, ambiguous = min(best, popular), true
+			}
+		}
+	}
+
+	if best != "" {
+		return best, bestDistance, ambiguous
+	}
+
+	// Only fix the TLD of other domains when the typed one doesn't exist, e.g. "acme.con" but not "acme.co"
+	for candidate, cost := range candidates {
+		if cost == emailTLDTypoCost {
+			return candidate, cost, false
+		}
+	}
+
+	return "", 0, false
+}
+
+func maxEmailTypoDistanceFor(domain string) float64 {
+	if name, _, _ := strings.Cut(domain, "."); len(name) < minEmailTypoNameLength {
+		return maxShortEmailTypoDistance
+	}
+
+	return maxEmailTypoDistance
+}
+
+// emailTypoDistance is the optimal string alignment distance between a and b, where typos which are easy to make on
+// a keyboard cost less: adjacent keys, swapped letters, and doubled or missed double letters.
+func emailTypoDistance(a, b string) float64 {
+	ra, rb := []rune(a), []rune(b)
+
+	d := make([][]float64, len(ra)+1)
+	for i := range d {
+		d[i] = make([]float64, len(rb)+1)
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		d[i][0] = d[i-1][0] + emailTypoInsertionCost(ra, i-1)
+	}
+
+	for j := 1; j <= len(rb); j++ {
+		d[0][j] = d[0][j-1] + emailTypoInsertionCost(rb, j-1)
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		for j := 1; j <= len(rb); j++ {
+			substitution := emailTypoCost
+
+			switch {
+			case ra[i-1] == rb[j-1]:
+				substitution = 0
+			case areAdjacentKeys(ra[i-1], rb[j-1]):
+				substitution = emailCloseTypoCost
+			}
+
+			d[i][j] = min(
+				d[i-1][j]+emailTypoInsertionCost(ra, i-1),
+				d[i][j-1]+emailTypoInsertionCost(rb, j-1),
+				d[i-1][j-1]+substitution,
+			)
+
+			if i > 1 && j > 1 && ra[i-1] == rb[j-2] && ra[i-2] == rb[j-1] {
+				d[i][j] = min(d[i][j], d[i-2][j-2]+emailCloseTypoCost)
+			}
+		}
+	}
+
+	return d[len(ra)][len(rb)]
+}
+
+// emailTypoInsertionCost is the cost of the extra rune s[i], cheaper when it doubles the previous one.
+func emailTypoInsertionCost(s []rune, i int) float64 {
+	if i > 0 && s[i] == s[i-1] {
+		return emailCloseTypoCost
+	}
+
+	return emailTypoCost
+}
+
+// areAdjacentKeys reports whether a and b are next to each other on a QWERTY keyboard.
+func areAdjacentKeys(a, b rune) bool {
+	rowA, colA, foundA := qwertyPosition(a)
+	rowB, colB, foundB := qwertyPosition(b)
+
+	if !foundA || !foundB {
+		return false
+	}
+
+	rowDiff, colDiff := rowA-rowB, colA-colB
+
+	return rowDiff >= -1 && rowDiff <= 1 && colDiff >= -1 && colDiff <= 1
+}
+
+func qwertyPosition(r rune) (int, float64, bool) {
+	for row, keys := range qwertyRows {
+		if col := strings.IndexRune(keys, r); col >= 0 {
+			return row, float64(col) + float64(row)/2, true
+		}
+	}
+
+	return 0, 0, false
+}
+
+// cutLast slices s around the last instance of sep.
+func cutLast(s, sep string) (string, string, bool) {
+	if i := strings.LastIndex(s, sep); i >= 0 {
+		return s[:i], s[i+len(sep):], true
+	}
+
+	return s, "", false
+}
@@ -0,0 +1,68 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSuggestEmailCorrection(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		email          string
+		wantEmail      string
+		wantConfidence float64
+		wantFound      bool
+	}{
+		{"Swapped letters", "john@gmial.com", "john@gmail.com", 0.83, true},
+		{"Missed double letter", "jane@outlok.fr", "jane@outlook.fr", 0.83, true},
+		{"Adjacent key", "john@hotmail.cim", "john@hotmail.com", 0.83, true},
+		{"Missing letter", "john@hotmal.com", "john@hotmail.com", 0.67, true},
+		{"Missed double letter in legacy typo domain", "john@yaho.com", "john@yahoo.com", 0.83, true},
+		{"TLD typo of popular domain", "john@gmail.con", "john@gmail.com", 0.92, true},
+		{"TLD typo of other domain", "John.Doe@Acme.cmo", "John.Doe@acme.com", 0.92, true},
+		{"Existing TLD typo of popular domain", "john@gmail.co", "john@gmail.com", 0.83, true},
+		{"Existing TLD of other domain", "john@acme.co", "", 0, false},
+		{"Correct popular domain", "john@gmail.com", "", 0, false},
+		{"Corporate domain", "john@surfe.com", "", 0, false},
+		{"Short domain far from short popular domains", "john@acme.com", "", 0, false},
+		{"Missing @", "john.gmail.com", "", 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			suggestion, found := SuggestEmailCorrection(tt.email)
+			require.Equal(t, tt.wantFound, found)
+			require.Equal(t, tt.wantEmail, suggestion.Email)
+			require.InDelta(t, tt.wantConfidence, suggestion.Confidence, 0.01)
+		})
+	}
+}
+
+func TestEmailTypoDistance(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		a, b     string
+		expected float64
+	}{
+		{"gmail.com", "gmail.com", 0},
+		{"gmial.com", "gmail.com", 0.5},
+		{"gnail.com", "gmail.com", 0.5},
+		{"gpail.com", "gmail.com", 1},
+		{"gmaill.com", "gmail.com", 0.5},
+		{"gmal.com", "gmail.com", 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.a, func(t *testing.T) {
+			t.Parallel()
+
+			require.InDelta(t, tt.expected, emailTypoDistance(tt.a, tt.b), 0.001)
+		})
+	}
+}
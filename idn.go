@@ -0,0 +1,66 @@
+package utils
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"github.com/surfe/utils/domainlist"
+)
+
+// DomainForm is the form in which internationalized domain names (IDNs) are returned. Domain helpers return them in
+// the form of their input by default, e.g. "müller.de" for "https://müller.de" and "xn--mller-kva.de" for
+// "https://xn--mller-kva.de", and in the form passed as their optional last argument otherwise.
+type DomainForm int
+
+const (
+	DomainFormASCII   DomainForm = iota // Punycode, e.g. "xn--mller-kva.de", to store, compare and resolve domains
+	DomainFormUnicode                   // e.g. "müller.de", to display domains
+)
+
+// ConvertDomain converts domain, or host, to the given form with domainlist.IDNA. Domains already in that form are
+// returned unchanged, e.g. ConvertDomain("Surfe.com", DomainFormASCII) returns "Surfe.com". It returns an error for
+// invalid IDNs, e.g. with malformed punycode or mixing scripts in ways IDNA2008 disallows. Examples:
+//
+//	ConvertDomain("müller.de", DomainFormASCII)          -> "xn--mller-kva.de"
+//	ConvertDomain("xn--mller-kva.de", DomainFormUnicode) -> "müller.de"
+func ConvertDomain(domain string, form DomainForm) (string, error) {
+	lower := strings.ToLower(domain)
+	hasPunycode := strings.HasPrefix(lower, "xn--") || strings.Contains(lower, ".xn--")
+	isASCII := !strings.ContainsFunc(domain, func(r rune) bool { return r >= utf8.RuneSelf })
+
+	switch {
+	case isASCII && !hasPunycode:
+		return domain, nil
+	case form == DomainFormUnicode:
+		return domainlist.IDNA.ToUnicode(domain)
+	default:
+		return domainlist.IDNA.ToASCII(domain)
+	}
+}
+
+// domainFormOf returns the form chosen by the optional form argument of domain helpers, by default that of s, their
+// input: DomainFormUnicode when s has non-ASCII characters, DomainFormASCII otherwise.
+func domainFormOf(s string, form []DomainForm) DomainForm {
+	switch {
+	case len(form) > 0:
+		return form[0]
+	case strings.ContainsFunc(s, func(r rune) bool { return r >= utf8.RuneSelf }):
+		return DomainFormUnicode
+	default:
+		return DomainFormASCII
+	}
+}
+
+// toDomainForm returns domain in the given form, or domain itself when it isn't a valid IDN.
+func toDomainForm(domain string, form DomainForm) string {
+	if converted, err := ConvertDomain(domain, form); err == nil {
+		return converted
+	}
+
+	return domain
+}
+
+// asciiDomain returns domain in DomainFormASCII, or domain itself when it isn't a valid IDN.
+func asciiDomain(domain string) string {
+	return toDomainForm(domain, DomainFormASCII)
+}
@@ -0,0 +1,193 @@
+package utils
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/surfe/utils/domainlist"
+)
+
+func TestConvertDomain(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		domain    string
+		form      DomainForm
+		expected  string
+		expectErr bool
+	}{
+		{domain: "müller.de", form: DomainFormASCII, expected: "xn--mller-kva.de"},
+		{domain: "MÜLLER.de", form: DomainFormASCII, expected: "xn--mller-kva.de"},
+		{domain: "xn--mller-kva.de", form: DomainFormASCII, expected: "xn--mller-kva.de"},
+		{domain: "xn--mller-kva.de", form: DomainFormUnicode, expected: "müller.de"},
+		{domain: "XN--MLLER-KVA.de", form: DomainFormUnicode, expected: "müller.de"},
+		{domain: "müller.de", form: DomainFormUnicode, expected: "müller.de"},
+		{domain: "пример.рф", form: DomainFormASCII, expected: "xn--e1afmkfd.xn--p1ai"},
+		{domain: "faß.de", form: DomainFormASCII, expected: "xn--fa-hia.de"},
+		{domain: "Surfe.com", form: DomainFormASCII, expected: "Surfe.com"},
+		{domain: "Surfe.com", form: DomainFormUnicode, expected: "Surfe.com"},
+		{domain: "my_site.example.com", form: DomainFormASCII, expected: "my_site.example.com"},
+		{domain: "xn--zz.com", form: DomainFormUnicode, expectErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.domain, func(t *testing.T) {
+			t.Parallel()
+
+			domain, err := ConvertDomain(tt.domain, tt.form)
+			if tt.expectErr {
+				require.Error(t, err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, domain)
+		})
+	}
+}
+
+func TestParseWebAddressIDN(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		input       string
+		form        DomainForm
+		expected    WebAddress
+		expectedErr error
+	}{
+		{
+			input: "https://www.Bücher.ch/a",
+			expected: WebAddress{
+				Scheme:    "https",
+				Host:      "www.xn--bcher-kva.ch",
+				Path:      "/a",
+				Subdomain: "www",
+				Domain:    "xn--bcher-kva.ch",
+				TLD:       "ch",
+			},
+		},
+		{
+			input: "https://www.xn--bcher-kva.ch/a",
+			form:  DomainFormUnicode,
+			expected: WebAddress{
+				Scheme:    "https",
+				Host:      "www.bücher.ch",
+				Path:      "/a",
+				Subdomain: "www",
+				Domain:    "bücher.ch",
+				TLD:       "ch",
+			},
+		},
+		{
+			input:    "пример.рф",
+			form:     DomainFormUnicode,
+			expected: WebAddress{Host: "пример.рф", Domain: "пример.рф", TLD: "рф"},
+		},
+		{
+			input:       "http://xn--zz.com",
+			expected:    WebAddress{Scheme: "http", Host: "xn--zz.com"},
+			expectedErr: ErrInvalidIDN,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			t.Parallel()
+
+			address, err := ParseWebAddress(tt.input, tt.form)
+			require.ErrorIs(t, err, tt.expectedErr)
+			require.Equal(t, tt.expected, address)
+		})
+	}
+}
+
+func TestDomainHelpersIDN(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, "müller.de", DomainFromURL("https://www.müller.de/kontakt"))
+	require.Equal(t, "xn--mller-kva.de", DomainFromURL("https://www.xn--mller-kva.de/kontakt"))
+	require.Equal(t, "xn--mller-kva.de", DomainFromURL("https://www.müller.de/kontakt", DomainFormASCII))
+	require.Equal(t, "müller.de", DomainFromURL("https://www.xn--mller-kva.de/kontakt", DomainFormUnicode))
+	require.Equal(t, "müller", DomainNameWithoutTLD("xn--mller-kva.de", DomainFormUnicode))
+	require.Equal(t, "müller.de/kontakt", ExtractHostAndPath("https://www.xn--mller-kva.de/kontakt/", DomainFormUnicode))
+	require.Equal(t, "müller.de", URLHostnameExtractor("www.müller.de"))
+	require.Equal(t, "xn--mller-kva.de", URLHostnameExtractor("www.müller.de", DomainFormASCII))
+	require.Equal(t, "müller.de/kontakt", ExtractHostAndPath("https://www.müller.de/kontakt/"))
+	require.Equal(t, "müller.de", DomainFromURLBypassingShortener("https://müller.de"))
+	require.Equal(t, "müller.de", DomainFromURLBypassingShortener("https://xn--mller-kva.de", DomainFormUnicode))
+
+	require.Equal(t, "müller.de", DomainFromEmail("jane@müller.de"))
+	require.Equal(t, "xn--mller-kva.de", DomainFromEmail("jane@müller.de", DomainFormASCII))
+	require.Equal(t, "müller.de", DomainFromEmail("jane@xn--mller-kva.de", DomainFormUnicode))
+}
+
+func TestToValidEmailIDN(t *testing.T) {
+	t.Parallel()
+
+	email, valid := ToValidEmail("Jane@Müller.de")
+	require.True(t, valid)
+	require.Equal(t, "jane@müller.de", email)
+
+	email, valid = ToValidEmail("Jane@Müller.de", DomainFormASCII)
+	require.True(t, valid)
+	require.Equal(t, "jane@xn--mller-kva.de", email)
+
+	email, valid = ToValidEmail("jane@xn--mller-kva.de")
+	require.True(t, valid)
+	require.Equal(t, "jane@xn--mller-kva.de", email)
+
+	email, valid = ToValidEmail("jane@xn--mller-kva.de", DomainFormUnicode)
+	require.True(t, valid)
+	require.Equal(t, "jane@müller.de", email)
+}
+
+func TestSameDomainsIDN(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		linkedinDomain string
+		crmDomain      string
+		expected       bool
+	}{
+		{"müller.de", "https://xn--mller-kva.de", true},
+		{"xn--mller-kva.de", "https://www.müller.de/kontakt", true},
+		{"MÜLLER.de", "müller.de", true},
+		{"müller.de", "muller.de", false},
+		{"xn--mller-kva.de", "müller", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.linkedinDomain+" "+tt.crmDomain, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tt.expected, SameDomains(tt.linkedinDomain, tt.crmDomain))
+		})
+	}
+}
+
+func TestIDNAgreement(t *testing.T) {
+	t.Parallel()
+
+	for _, host := range []string{"MÜLLER.de", "faß.de", "Ｂücher.de", "xn--mller-kva.de"} {
+		t.Run(host, func(t *testing.T) {
+			t.Parallel()
+
+			domain := DomainFromURL("https://"+host, DomainFormASCII)
+			require.True(t, domainlist.New(host).Contains(domain))
+
+			verdict := ValidateEmail("jane@" + host)
+			require.True(t, verdict.Valid)
+			require.Equal(t, "jane@"+domain, verdict.Email)
+		})
+	}
+}
+
+func TestValidateEmailHostname(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, EmailReasonInvalidDomain, ValidateEmail("jane@acme_corp.com").Reason)
+	require.Equal(t, EmailReasonInvalidDomain, ValidateEmail("jane@"+strings.Repeat("a", 64)+".com").Reason)
+	require.Equal(t, "acme_corp.com", DomainFromURL("https://acme_corp.com"))
+}
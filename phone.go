@@ -1,9 +1,68 @@
 package utils
 
-import "regexp"
+import (
+	"errors"
 
-var personalPhone = regexp.MustCompile(`^((\+|00)33|0)\s*(6|7)([\s.-]*\d{2}){4}$`)
+	"github.com/surfe/utils/phoneutils"
+)
 
+// defaultPersonalPhoneRegion is used for numbers without an international prefix.
+const defaultPersonalPhoneRegion = "FR"
+
+// PhoneRegionSource is the signal which decided the country of a phone parsed by ParsePhoneWithLocation.
+type PhoneRegionSource string
+
+const (
+	PhoneRegionSourceNumber   PhoneRegionSource = "number"   // International prefix written in the phone
+	PhoneRegionSourceLocation PhoneRegionSource = "location" // Country found in the location
+	PhoneRegionSourceFallback PhoneRegionSource = "fallback" // Fallback region given by the caller
+)
+
+// IsPersonalPhone reports whether phone may be a direct personal line, i.e. a mobile number in any supported country.
+// Numbers without an international prefix are interpreted as French numbers.
 func IsPersonalPhone(phone string) bool {
-	return personalPhone.MatchString(phone)
+	return IsPersonalPhoneInRegion(phone, defaultPersonalPhoneRegion)
+}
+
+// IsPersonalPhoneInRegion is like IsPersonalPhone, but interprets numbers without an international prefix
+// as national numbers of region (ISO 3166-1 alpha-2).
+// Numbers of regions where mobile and fixed lines can't be told apart, e.g. US, are considered personal.
+func IsPersonalPhoneInRegion(phone, region string) bool {
+	return phoneutils.IsMobileType(phoneutils.NumberType(phone, region))
+}
+
+// ParsePhoneWithLocation parses phone, using the country of a free-text location, e.g. a LinkedIn location like
+// "Lyon, Auvergne-Rhône-Alpes, France", as the region of numbers without an international prefix.
+// fallbackRegion (ISO 3166-1 alpha-2) is used when the location has no country and may be empty.
+// It also returns which signal decided the country of the number, or an empty source with the error.
+// Examples:
+//
+//	ParsePhoneWithLocation("+44 20 7946 0000", "Paris, France", "")   -> +442079460000, number
+//	ParsePhoneWithLocation("06 12 34 56 78", "Lyon, France", "US")     -> +33612345678, location
+//	ParsePhoneWithLocation("(415) 555-2671", "Remote", "US")           -> +14155552671, fallback
+func ParsePhoneWithLocation(phone, location, fallbackRegion string) (phoneutils.PhoneNumber, PhoneRegionSource, error) {
+	number, err := phoneutils.Parse(phone, "")
+	if err == nil {
+		return number, PhoneRegionSourceNumber, nil
+	}
+
+	if !errors.Is(err, phoneutils.ErrInvalidCountryCode) {
+		return phoneutils.PhoneNumber{}, "", err
+	}
+
+	region, source := fallbackRegion, PhoneRegionSourceFallback
+	if alpha2, found := GetCountryAlpha2FromLocation(location); found {
+		region, source = alpha2, PhoneRegionSourceLocation
+	}
+
+	if region == "" {
+		return phoneutils.PhoneNumber{}, "", err
+	}
+
+	number, err = phoneutils.Parse(phone, region)
+	if err != nil {
+		return phoneutils.PhoneNumber{}, "", err
+	}
+
+	return number, source, nil
 }
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"github.com/surfe/utils/phoneutils"
 )
 
 func TestIsPersonalPhone(t *testing.T) {
@@ -15,6 +16,13 @@ func TestIsPersonalPhone(t *testing.T) {
 	}{
 		{"+33612345678", true},
 		{"0612345678", true},
+		{"06 12 34 56 78", true},
+		{"+44 7400 123456", true},
+		{"+49 151 23456789", true},
+		{"+1 415 555 2671", true},
+		{"+33 1 42 68 53 00", false},
+		{"+44 20 7946 0000", false},
+		{"0800 123 456", false},
 		{"1234567890", false},
 		{"", false},
 	}
@@ -27,3 +35,102 @@ func TestIsPersonalPhone(t *testing.T) {
 		})
 	}
 }
+
+func TestIsPersonalPhoneInRegion(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		phone    string
+		region   string
+		expected bool
+	}{
+		{"07400 123456", "GB", true},
+		{"020 7946 0000", "GB", false},
+		{"0151 23456789", "DE", true},
+		{"612 34 56 78", "ES", true},
+		{"06 12 34 56 78", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.region+" "+tt.phone, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tt.expected, IsPersonalPhoneInRegion(tt.phone, tt.region))
+		})
+	}
+}
+
+func TestParsePhoneWithLocation(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		phone          string
+		location       string
+		fallbackRegion string
+		wantE164       string
+		wantSource     PhoneRegionSource
+		wantErr        error
+	}{
+		{
+			name:       "Given international number should ignore location",
+			phone:      "+44 20 7946 0000",
+			location:   "Paris, France",
+			wantE164:   "+442079460000",
+			wantSource: PhoneRegionSourceNumber,
+		},
+		{
+			name:           "Given national number should use country of location",
+			phone:          "06 12 34 56 78",
+			location:       "Lyon, Auvergne-Rhône-Alpes, France",
+			fallbackRegion: "US",
+			wantE164:       "+33612345678",
+			wantSource:     PhoneRegionSourceLocation,
+		},
+		{
+			name:       "Given US location should parse US national number",
+			phone:      "(415) 555-2671",
+			location:   "San Francisco, California, United States",
+			wantE164:   "+14155552671",
+			wantSource: PhoneRegionSourceLocation,
+		},
+		{
+			name:           "Given location without country should use fallback region",
+			phone:          "020 7946 0000",
+			location:       "Remote",
+			fallbackRegion: "GB",
+			wantE164:       "+442079460000",
+			wantSource:     PhoneRegionSourceFallback,
+		},
+		{
+			name:     "Given no region at all should return error",
+			phone:    "06 12 34 56 78",
+			location: "",
+			wantErr:  phoneutils.ErrInvalidCountryCode,
+		},
+		{
+			name:     "Given text should return error",
+			phone:    "not found",
+			location: "Lyon, France",
+			wantErr:  phoneutils.ErrNotANumber,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			number, source, err := ParsePhoneWithLocation(tt.phone, tt.location, tt.fallbackRegion)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				require.Empty(t, source)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.wantE164, number.E164)
+			require.Equal(t, tt.wantSource, source)
+		})
+	}
+}
@@ -0,0 +1,195 @@
+package phoneutils
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Leniency controls how strictly candidates found in text must look like phone numbers.
+type Leniency int
+
+const (
+	// LeniencyPossible accepts candidates whose length is possible for their region.
+	LeniencyPossible Leniency = iota
+	// LeniencyValid accepts valid numbers, rejecting dates, postal codes, and digits glued to identifiers like order IDs.
+	LeniencyValid
+	// LeniencyStrict is LeniencyValid for numbers written with an international or national prefix,
+	// and consistent separators.
+	LeniencyStrict
+)
+
+const (
+	minValidCandidateDigits = 6
+	maxCandidateDigits      = 15 // Longest E.164 number, longer candidates are several numbers written one after the other
+)
+
+// NumberMatch is a phone number found in text.
+type NumberMatch struct {
+	Raw    string // Matched text, e.g. "+33 6 12 34 56 78"
+	Start  int    // Byte offset of the match in text
+	End    int    // Byte offset right after the match in text
+	Number PhoneNumber
+}
+
+var (
+	// Digit groups separated by at most two spaces, dashes, dots or parentheses
+	rePhoneCandidate = regexp.MustCompile(`\+?\(?\d(?:[ \t\x{00A0}\-.()]{0,2}\d)+`)
+	reGroupBoundary  = regexp.MustCompile(`[ \t\x{00A0}]+`)
+	reExtensionAfter = regexp.MustCompile(`^` + extensionPattern)
+	reDateLike       = regexp.MustCompile(`^(?:\d{1,2}[-./]\d{1,2}[-./]\d{2,4}|\d{4}[-./]\d{1,2}[-./]\d{1,2})$`)
+
+	// Words announcing identifiers which aren't phone numbers, e.g. "Order 0612345678"
+	identifierWords = []string{"order", "invoice", "ref", "reference", "id", "no", "n°", "nr", "commande", "facture",
+		"siret", "siren", "iban", "tracking", "account", "customer", "client"}
+)
+
+// FindNumbers finds the phone numbers in text, e.g. an email signature or a notes field.
+// Numbers without an international prefix are parsed as national numbers of defaultRegion.
+// Example:
+//
+//	FindNumbers("Call me on 06 12 34 56 78 before 12/05/2024", "FR", LeniencyValid)
+//	-> [{Raw: "06 12 34 56 78", Start: 11, End: 25, Number: +33612345678}]
+func FindNumbers(text, defaultRegion string, leniency Leniency) []NumberMatch {
+	var matches []NumberMatch
+
+	for _, loc := range rePhoneCandidate.FindAllStringIndex(text, -1) {
+		matches = append(matches, findInCandidate(text, loc[0], loc[1], defaultRegion, leniency)...)
+	}
+
+	return matches
+}
+
+// findInCandidate returns the number text[start:end] is. When the candidate is too long to be one number, e.g.
+// "01 23 45 67 89 06 11 22 33 44", it returns the numbers found by splitting it at the spaces between digit groups,
+// the longest first.
+func findInCandidate(text string, start, end int, defaultRegion string, leniency Leniency) []NumberMatch {
+	if match, found := matchCandidate(text, start, end, defaultRegion, leniency); found {
+		return []NumberMatch{match}
+	}
+
+	if countDigits(text[start:end]) <= maxCandidateDigits {
+		return nil
+	}
+
+	boundaries := reGroupBoundary.FindAllStringIndex(text[start:end], -1)
+	if len(boundaries) == 0 {
+		return nil
+	}
+
+	for _, boundary := range slices.Backward(boundaries) {
+		if match, found := matchCandidate(text, start, start+boundary[0], defaultRegion, leniency); found {
+			return append([]NumberMatch{match}, findInCandidate(text, start+boundary[1], end, defaultRegion, leniency)...)
+		}
+	}
+
+	// No number starts with the first group
+	return findInCandidate(text, start+boundaries[0][1], end, defaultRegion, leniency)
+}
+
+// matchCandidate parses text[start:end], extended with the extension following it if any, and checks it against
+// leniency.
+func matchCandidate(text string, start, end int, defaultRegion string, leniency Leniency) (NumberMatch, bool) {
+	if ext := reExtensionAfter.FindStringIndex(text[end:]); ext != nil {
+		end += ext[1]
+	}
+
+	raw := text[start:end]
+
+	number, err := Parse(raw, defaultRegion)
+	if err != nil || !number.IsPossible {
+		return NumberMatch{}, false
+	}
+
+	if leniency >= LeniencyValid && !isValidCandidate(text, start, end, number) {
+		return NumberMatch{}, false
+	}
+
+	if leniency >= LeniencyStrict && !isStrictCandidate(raw, number) {
+		return NumberMatch{}, false
+	}
+
+	return NumberMatch{Raw: raw, Start: start, End: end, Number: number}, true
+}
+
+func countDigits(s string) int {
+	digits := 0
+
+	for _, r := range s {
+		if unicode.IsDigit(r) {
+			digits++
+		}
+	}
+
+	return digits
+}
+
+func isValidCandidate(text string, start, end int, number PhoneNumber) bool {
+	raw := text[start:end]
+
+	if !number.IsValid || len(number.NationalNumber) < minValidCandidateDigits || reDateLike.MatchString(raw) {
+		return false
+	}
+
+	before, _ := utf8.DecodeLastRuneInString(text[:start])
+	if before != utf8.RuneError && (unicode.IsLetter(before) || unicode.IsDigit(before) || strings.ContainsRune("#_-/", before)) {
+		return false
+	}
+
+	after, _ := utf8.DecodeRuneInString(text[end:])
+	if after != utf8.RuneError && (unicode.IsLetter(after) || unicode.IsDigit(after) || strings.ContainsRune("%€$£_/", after)) {
+		return false
+	}
+
+	return !followsIdentifierWord(text[:start])
+}
+
+// followsIdentifierWord reports whether the last word before a candidate announces an identifier.
+func followsIdentifierWord(prefix string) bool {
+	words := strings.Fields(strings.ToLower(prefix))
+	if len(words) == 0 {
+		return false
+	}
+
+	return slices.Contains(identifierWords, strings.TrimRight(words[len(words)-1], ":.#"))
+}
+
+func isStrictCandidate(raw string, number PhoneNumber) bool {
+	if strings.ContainsRune(raw, '-') && strings.ContainsRune(raw, '.') {
+		return false // Mixed separators, e.g. "06.12-34.56.78"
+	}
+
+	if strings.HasPrefix(raw, "+") {
+		return true
+	}
+
+	md, found := regionMetadataByRegion[number.Region]
+	if !found {
+		return false
+	}
+
+	digits, _, _ := normalizeDigits(raw)
+	if strings.HasPrefix(digits, md.internationalPrefix) {
+		return true
+	}
+
+	return !dialledWithNationalPrefix(number, md) || strings.HasPrefix(digits, md.nationalPrefix)
+}
+
+// dialledWithNationalPrefix reports whether the region writes national numbers like this one with its national prefix,
+// e.g. "06 12 34 56 78" in France but "(415) 555-2671" in the US.
+func dialledWithNationalPrefix(number PhoneNumber, md *regionMetadata) bool {
+	if md.nationalPrefix == "" {
+		return false
+	}
+
+	for _, f := range md.formats {
+		if f.pattern.MatchString(number.NationalNumber) {
+			return strings.Contains(f.national, md.nationalPrefix+"$")
+		}
+	}
+
+	return true
+}
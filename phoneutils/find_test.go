@@ -0,0 +1,136 @@
+package phoneutils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFindNumbers(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		text     string
+		region   string
+		leniency Leniency
+		expected []string
+	}{
+		{
+			name:     "Given signature with two numbers should find both",
+			text:     "John Doe\nMobile: 06 12 34 56 78\nOffice: +33 (0)1 42 68 53 00",
+			region:   "FR",
+			leniency: LeniencyValid,
+			expected: []string{"+33612345678", "+33142685300"},
+		},
+		{
+			name:     "Given US number with parentheses should find it",
+			text:     "Reach me at (415) 555-2671, thanks!",
+			region:   "US",
+			leniency: LeniencyStrict,
+			expected: []string{"+14155552671"},
+		},
+		{
+			name:     "Given date should not find it when valid leniency",
+			text:     "Meeting on 2024.05.12 in room 4",
+			region:   "DK",
+			leniency: LeniencyValid,
+			expected: nil,
+		},
+		{
+			name:     "Given date should find it when possible leniency",
+			text:     "Meeting on 2024.05.12 in room 4",
+			region:   "DK",
+			leniency: LeniencyPossible,
+			expected: []string{"+4520240512"},
+		},
+		{
+			name:     "Given postal code should not find it",
+			text:     "1010 Wien, Austria",
+			region:   "AT",
+			leniency: LeniencyValid,
+			expected: nil,
+		},
+		{
+			name:     "Given order ID should not find it",
+			text:     "Order #0612345678 and ORD-0612345678 and Invoice: 0612345678 shipped",
+			region:   "FR",
+			leniency: LeniencyValid,
+			expected: nil,
+		},
+		{
+			name:     "Given national number without prefix should only find it when not strict",
+			text:     "Tel 6 12 34 56 78",
+			region:   "FR",
+			leniency: LeniencyValid,
+			expected: []string{"+33612345678"},
+		},
+		{
+			name:     "Given national number without prefix should not find it when strict",
+			text:     "Tel 6 12 34 56 78",
+			region:   "FR",
+			leniency: LeniencyStrict,
+			expected: nil,
+		},
+		{
+			name:     "Given mixed separators should not find it when strict",
+			text:     "Tel 06.12-34.56-78",
+			region:   "FR",
+			leniency: LeniencyStrict,
+			expected: nil,
+		},
+		{
+			name:     "Given two numbers separated by spaces should find both",
+			text:     "Tel: 01 23 45 67 89 06 11 22 33 44",
+			region:   "FR",
+			leniency: LeniencyValid,
+			expected: []string{"+33123456789", "+33611223344"},
+		},
+		{
+			name:     "Given three numbers separated by spaces should find them",
+			text:     "T 415 555 2671  415 555 2672  415 555 2673",
+			region:   "US",
+			leniency: LeniencyValid,
+			expected: []string{"+14155552671", "+14155552672", "+14155552673"},
+		},
+		{
+			name:     "Given long digit run should not split it",
+			text:     "IBAN 7630006000011234567890189",
+			region:   "FR",
+			leniency: LeniencyPossible,
+			expected: nil,
+		},
+		{
+			name:     "Given text without numbers should find nothing",
+			text:     "Best regards",
+			region:   "FR",
+			leniency: LeniencyPossible,
+			expected: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var actual []string
+
+			for _, m := range FindNumbers(tt.text, tt.region, tt.leniency) {
+				require.Equal(t, m.Raw, tt.text[m.Start:m.End])
+				actual = append(actual, m.Number.E164)
+			}
+
+			require.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestFindNumbersWithExtension(t *testing.T) {
+	t.Parallel()
+
+	matches := FindNumbers("Switchboard: +44 20 7946 0000 ext. 123 (ask for Jane)", "GB", LeniencyStrict)
+	require.Len(t, matches, 1)
+	require.Equal(t, "+44 20 7946 0000 ext. 123", matches[0].Raw)
+	require.Equal(t, "+442079460000", matches[0].Number.E164)
+	require.Equal(t, "123", matches[0].Number.Extension)
+}
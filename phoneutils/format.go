@@ -0,0 +1,148 @@
+package phoneutils
+
+import (
+	"strconv"
+	"strings"
+)
+
+// FormatStyle is the notation used to format a parsed phone number.
+type FormatStyle int
+
+const (
+	FormatE164          FormatStyle = iota // "+33612345678"
+	FormatInternational                    // "+33 6 12 34 56 78"
+	FormatNational                         // "06 12 34 56 78"
+	FormatRFC3966                          // "tel:+33-6-12-34-56-78"
+)
+
+// ExtensionStyle is the separator written between a formatted number and its extension.
+type ExtensionStyle string
+
+const (
+	ExtensionStyleExt   ExtensionStyle = " ext. "  // "+33 1 42 68 53 00 ext. 42"
+	ExtensionStyleX     ExtensionStyle = " x"      // "+33 1 42 68 53 00 x42"
+	ExtensionStyleHash  ExtensionStyle = " #"      // "+33 1 42 68 53 00 #42"
+	ExtensionStylePoste ExtensionStyle = " poste " // "+33 1 42 68 53 00 poste 42"
+)
+
+// Format formats a parsed phone number in the given style, using the grouping patterns of its region.
+// Numbers of regions without grouping patterns are formatted without grouping.
+// Extensions are written in ExtensionStyleExt, see FormatWithExtension.
+func Format(number PhoneNumber, style FormatStyle) string {
+	return FormatWithExtension(number, style, ExtensionStyleExt)
+}
+
+// FormatWithExtension is like Format, but writes the extension, if any, in the given style.
+// E.164 has no notation for extensions, which are dropped, and RFC 3966 always uses ";ext=".
+func FormatWithExtension(number PhoneNumber, style FormatStyle, extensionStyle ExtensionStyle) string {
+	formatted := formatNumber(number, style)
+	if formatted == "" || number.Extension == "" {
+		return formatted
+	}
+
+	switch style {
+	case FormatE164:
+		return formatted
+	case FormatRFC3966:
+		return formatted + ";ext=" + number.Extension
+	default:
+		return formatted + string(extensionStyle) + number.Extension
+	}
+}
+
+// FormatOutOfCountry formats a parsed phone number the way it is dialled from fromRegion (ISO 3166-1 alpha-2).
+// Examples for +33612345678:
+//
+//	from "DE" -> "00 33 6 12 34 56 78"
+//	from "US" -> "011 33 6 12 34 56 78"
+//	from "FR" -> "06 12 34 56 78"
+func FormatOutOfCountry(number PhoneNumber, fromRegion string) string {
+	if number.E164 == "" {
+		return ""
+	}
+
+	formatted := formatOutOfCountry(number, strings.ToUpper(fromRegion))
+	if number.Extension != "" {
+		formatted += string(ExtensionStyleExt) + number.Extension
+	}
+
+	return formatted
+}
+
+// FormatPhonesAndProvidersAs is like FormatPhonesAndProviders, but formats each phone in the given style.
+// Phones without an international prefix are parsed as national numbers of defaultRegion,
+// phones which can't be parsed are kept as-is.
+func FormatPhonesAndProvidersAs(providers, phones []string, defaultRegion string, style FormatStyle) string {
+	formatted := make([]string, len(phones))
+
+	for i, phone := range phones {
+		formatted[i] = phone
+
+		if number, err := Parse(phone, defaultRegion); err == nil {
+			formatted[i] = Format(number, style)
+		}
+	}
+
+	return FormatPhonesAndProviders(providers, formatted)
+}
+
+func formatNumber(number PhoneNumber, style FormatStyle) string {
+	if number.E164 == "" {
+		return ""
+	}
+
+	switch style {
+	case FormatInternational:
+		return "+" + strconv.Itoa(number.CountryCode) + " " + groupNationalNumber(number, false)
+	case FormatNational:
+		return groupNationalNumber(number, true)
+	case FormatRFC3966:
+		return "tel:" + strings.ReplaceAll(formatNumber(number, FormatInternational), " ", "-")
+	default:
+		return number.E164
+	}
+}
+
+func formatOutOfCountry(number PhoneNumber, fromRegion string) string {
+	md, found := regionMetadataByRegion[fromRegion]
+	if !found {
+		return formatNumber(number, FormatInternational)
+	}
+
+	if md.countryCode != number.CountryCode {
+		return md.internationalPrefix + " " + strconv.Itoa(number.CountryCode) + " " + groupNationalNumber(number, false)
+	}
+
+	// Regions sharing a country code, e.g. US and CA, dial each other with the national prefix
+	if number.Region != fromRegion && md.nationalPrefix != "" {
+		return md.nationalPrefix + " " + groupNationalNumber(number, false)
+	}
+
+	return formatNumber(number, FormatNational)
+}
+
+// groupNationalNumber groups the national number using the first matching format of its region.
+func groupNationalNumber(number PhoneNumber, national bool) string {
+	md, found := regionMetadataByRegion[number.Region]
+	if !found {
+		return number.NationalNumber
+	}
+
+	for _, f := range md.formats {
+		if !f.pattern.MatchString(number.NationalNumber) {
+			continue
+		}
+
+		if national {
+			return f.pattern.ReplaceAllString(number.NationalNumber, f.national)
+		}
+
+		return f.pattern.ReplaceAllString(number.NationalNumber, f.international)
+	}
+
+	if national {
+		return md.nationalPrefix + number.NationalNumber
+	}
+
+	return number.NationalNumber
+}
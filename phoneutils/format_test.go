@@ -0,0 +1,119 @@
+package phoneutils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormat(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		raw      string
+		region   string
+		style    FormatStyle
+		expected string
+	}{
+		{"French E.164", "06 12 34 56 78", "FR", FormatE164, "+33612345678"},
+		{"French international", "06 12 34 56 78", "FR", FormatInternational, "+33 6 12 34 56 78"},
+		{"French national", "+33612345678", "", FormatNational, "06 12 34 56 78"},
+		{"French RFC 3966", "06 12 34 56 78", "FR", FormatRFC3966, "tel:+33-6-12-34-56-78"},
+		{"UK London national", "+442079460000", "", FormatNational, "020 7946 0000"},
+		{"UK mobile international", "07400 123456", "GB", FormatInternational, "+44 7400 123456"},
+		{"US national", "+14155552671", "", FormatNational, "(415) 555-2671"},
+		{"US international", "415.555.2671", "US", FormatInternational, "+1 415-555-2671"},
+		{"German mobile national", "+4915123456789", "", FormatNational, "0151 23456789"},
+		{"Italian fixed line keeps leading zero", "+390669821234", "", FormatInternational, "+39 06 6982 1234"},
+		{"Swedish mobile national", "+46701234567", "", FormatNational, "070-123 45 67"},
+		{"Region without metadata", "+8613800138000", "", FormatInternational, "+86 13800138000"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			number, err := Parse(tt.raw, tt.region)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, Format(number, tt.style))
+		})
+	}
+}
+
+func TestFormatOutOfCountry(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		raw        string
+		fromRegion string
+		expected   string
+	}{
+		{"From Germany", "+33612345678", "DE", "00 33 6 12 34 56 78"},
+		{"From US", "+33612345678", "US", "011 33 6 12 34 56 78"},
+		{"From same region", "+33612345678", "fr", "06 12 34 56 78"},
+		{"From region sharing country code", "+15145550123", "US", "1 514-555-0123"},
+		{"From unknown region", "+33612345678", "", "+33 6 12 34 56 78"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			number, err := Parse(tt.raw, "")
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, FormatOutOfCountry(number, tt.fromRegion))
+		})
+	}
+}
+
+func TestFormatPhonesAndProvidersAs(t *testing.T) {
+	t.Parallel()
+
+	actual := FormatPhonesAndProvidersAs(
+		[]string{"Provider1", "Provider2"},
+		[]string{"06 12 34 56 78", "not found", "+442079460000"},
+		"FR",
+		FormatInternational,
+	)
+	require.Equal(t, "Provider1: +33 6 12 34 56 78, Provider2: not found, +44 20 7946 0000", actual)
+}
+
+func TestFormatZeroValue(t *testing.T) {
+	t.Parallel()
+
+	require.Empty(t, Format(PhoneNumber{}, FormatInternational))
+	require.Empty(t, FormatOutOfCountry(PhoneNumber{}, "FR"))
+}
+
+func TestFormatWithExtension(t *testing.T) {
+	t.Parallel()
+
+	number, err := Parse("01 42 68 53 00 poste 12", "FR")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name           string
+		style          FormatStyle
+		extensionStyle ExtensionStyle
+		expected       string
+	}{
+		{"E.164 drops extension", FormatE164, ExtensionStyleExt, "+33142685300"},
+		{"International with ext", FormatInternational, ExtensionStyleExt, "+33 1 42 68 53 00 ext. 12"},
+		{"National with x", FormatNational, ExtensionStyleX, "01 42 68 53 00 x12"},
+		{"National with hash", FormatNational, ExtensionStyleHash, "01 42 68 53 00 #12"},
+		{"National with poste", FormatNational, ExtensionStylePoste, "01 42 68 53 00 poste 12"},
+		{"RFC 3966 uses ext parameter", FormatRFC3966, ExtensionStyleX, "tel:+33-1-42-68-53-00;ext=12"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tt.expected, FormatWithExtension(number, tt.style, tt.extensionStyle))
+		})
+	}
+
+	require.Equal(t, "00 33 1 42 68 53 00 ext. 12", FormatOutOfCountry(number, "DE"))
+}
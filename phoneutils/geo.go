@@ -0,0 +1,105 @@
+package phoneutils
+
+import (
+	_ "embed"
+	"slices"
+	"strings"
+)
+
+//go:embed geo_prefixes.txt
+var geoPrefixesData string
+
+// geoPrefixesByRegion holds the lines of geo_prefixes.txt by region.
+var geoPrefixesByRegion = parseGeoPrefixes(geoPrefixesData)
+
+// geoPrefix is the area and timezones of national numbers starting with one of prefixes, or of the whole region
+// when prefixes is empty.
+type geoPrefix struct {
+	prefixes  []string
+	area      string
+	timezones []string
+}
+
+// Location is the likely location of a phone number, inferred from its prefix.
+type Location struct {
+	Region    string   // ISO 3166-1 alpha-2 code, e.g. "US"
+	Area      string   // State, province or city, e.g. "California", empty when only the region is known
+	Timezones []string // Candidate IANA timezones, most likely first, e.g. ["America/Los_Angeles"]
+}
+
+// Geolocate infers the likely location of a parsed number from the embedded prefix tables, without network access.
+// Only geographic numbers are located to an area, mobile, toll-free, premium, VoIP and pager numbers get the
+// timezones of their whole region. Examples:
+//
+//	+14155552671  -> US, California, [America/Los_Angeles]
+//	+15145550123  -> CA, Quebec, [America/Toronto]
+//	+34922123456  -> ES, Santa Cruz de Tenerife, [Atlantic/Canary]
+//	+33612345678  -> FR, "", [Europe/Paris]
+func Geolocate(number PhoneNumber) Location {
+	location := Location{Region: number.Region}
+	geographic := isGeographicType(TypeOf(number))
+
+	prefixes := geoPrefixesByRegion[number.Region]
+	best, bestLength := -1, -1
+
+	for i, gp := range prefixes {
+		if len(gp.prefixes) == 0 && bestLength < 0 {
+			best, bestLength = i, 0
+		}
+
+		if !geographic {
+			continue
+		}
+
+		for _, prefix := range gp.prefixes {
+			if len(prefix) > bestLength && strings.HasPrefix(number.NationalNumber, prefix) {
+				best, bestLength = i, len(prefix)
+			}
+		}
+	}
+
+	if best >= 0 {
+		location.Area = prefixes[best].area
+		location.Timezones = slices.Clone(prefixes[best].timezones)
+	}
+
+	return location
+}
+
+// isGeographicType reports whether numbers of the given type are tied to the area of their prefix.
+// Numbers of unknown type, e.g. of regions without metadata, are assumed to be.
+func isGeographicType(phoneType PhoneType) bool {
+	switch phoneType {
+	case PhoneTypeFixedLine, PhoneTypeFixedLineOrMobile, PhoneTypeUnknown:
+		return true
+	default:
+		return false
+	}
+}
+
+// parseGeoPrefixes parses lines of "region|prefixes|area|timezones", skipping blank lines and "#" comments.
+// It panics on malformed lines, which can only come from the embedded table.
+func parseGeoPrefixes(data string) map[string][]geoPrefix {
+	byRegion := make(map[string][]geoPrefix)
+
+	for line := range strings.Lines(data) {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		fields := strings.Split(line, "|")
+		if len(fields) != 4 || fields[0] == "" || fields[3] == "" {
+			panic("phoneutils: malformed geo prefix line: " + line)
+		}
+
+		gp := geoPrefix{area: fields[2], timezones: strings.Split(fields[3], ",")}
+		if fields[1] != "" {
+			gp.prefixes = strings.Split(fields[1], ",")
+		}
+
+		byRegion[fields[0]] = append(byRegion[fields[0]], gp)
+	}
+
+	return byRegion
+}
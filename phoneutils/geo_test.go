@@ -0,0 +1,108 @@
+package phoneutils
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGeolocate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		raw      string
+		region   string
+		expected Location
+	}{
+		{
+			name:     "Given San Francisco number should return California",
+			raw:      "+1 415 555 2671",
+			expected: Location{Region: "US", Area: "California", Timezones: []string{"America/Los_Angeles"}},
+		},
+		{
+			name:     "Given El Paso number should return Texas mountain time",
+			raw:      "(915) 555-0123",
+			region:   "US",
+			expected: Location{Region: "US", Area: "Texas", Timezones: []string{"America/Denver"}},
+		},
+		{
+			name:     "Given Montreal number should return Quebec",
+			raw:      "+1 514-555-0123",
+			expected: Location{Region: "CA", Area: "Quebec", Timezones: []string{"America/Toronto"}},
+		},
+		{
+			name: "Given US toll-free number should return whole region",
+			raw:  "+1 800 555 0199",
+			expected: Location{Region: "US", Timezones: []string{
+				"America/New_York", "America/Chicago", "America/Denver", "America/Phoenix",
+				"America/Los_Angeles", "America/Anchorage", "Pacific/Honolulu",
+			}},
+		},
+		{
+			name:     "Given Paris number should return Île-de-France",
+			raw:      "01 42 68 53 00",
+			region:   "FR",
+			expected: Location{Region: "FR", Area: "Île-de-France", Timezones: []string{"Europe/Paris"}},
+		},
+		{
+			name:     "Given French mobile should return whole region",
+			raw:      "06 12 34 56 78",
+			region:   "FR",
+			expected: Location{Region: "FR", Timezones: []string{"Europe/Paris"}},
+		},
+		{
+			name:     "Given longer prefix should win",
+			raw:      "+44 161 496 0000",
+			expected: Location{Region: "GB", Area: "Manchester", Timezones: []string{"Europe/London"}},
+		},
+		{
+			name:     "Given Canary Islands number should return Atlantic timezone",
+			raw:      "+34 922 123 456",
+			expected: Location{Region: "ES", Area: "Santa Cruz de Tenerife", Timezones: []string{"Atlantic/Canary"}},
+		},
+		{
+			name:     "Given region without prefixes should return whole region",
+			raw:      "+86 138 0013 8000",
+			expected: Location{Region: "CN", Timezones: []string{"Asia/Shanghai"}},
+		},
+		{
+			name:     "Given region without timezones should return region only",
+			raw:      "+976 1123 4567",
+			expected: Location{Region: "MN"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			number, err := Parse(tt.raw, tt.region)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, Geolocate(number))
+		})
+	}
+}
+
+func TestGeoPrefixesTable(t *testing.T) {
+	t.Parallel()
+
+	for region, prefixes := range geoPrefixesByRegion {
+		md, hasMetadata := regionMetadataByRegion[region]
+
+		for _, gp := range prefixes {
+			for _, tz := range gp.timezones {
+				_, err := time.LoadLocation(tz)
+				require.NoError(t, err, "region %s", region)
+			}
+
+			for _, prefix := range gp.prefixes {
+				if hasMetadata && md.countryCode == 1 {
+					// Area codes must resolve to the region they are listed under
+					require.Equal(t, region, regionForNumber(1, prefix+"5550123"), "area code %s", prefix)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,169 @@
+package phoneutils
+
+import "strings"
+
+// MatchStrength tells how confidently two phone numbers designate the same line, from weakest to strongest.
+type MatchStrength int
+
+const (
+	// MatchNone means the numbers differ.
+	MatchNone MatchStrength = iota
+	// MatchShortNumber means one national number ends with the other, e.g. a number written without its area code.
+	MatchShortNumber
+	// MatchNationalNumber means the national numbers are equal, but the country code is missing on at least one side.
+	MatchNationalNumber
+	// MatchExact means both numbers have the same E.164 representation.
+	MatchExact
+)
+
+const minShortMatchDigits = 6
+
+// Match compares two raw phone numbers. Numbers without an international prefix are compared on their national number.
+// Examples:
+//
+//	Match("+44 20 7946 0000", "+44 (0)20 7946 0000") -> MatchExact
+//	Match("+44 20 7946 0000", "020 7946 0000")       -> MatchNationalNumber
+//	Match("+44 20 7946 0000", "7946 0000")           -> MatchShortNumber
+//	Match("+44 20 7946 0000", "+33 20 7946 0000")    -> MatchNone
+//
+// Numbers with different extensions never match, while a missing extension doesn't prevent a match.
+func Match(a, b string) MatchStrength {
+	return matchInRegion(a, b, "")
+}
+
+// Dedupe removes the phones designating the same line as previous ones, i.e. matching each of them at least with
+// MatchNationalNumber, so that the same number with two different extensions is kept twice.
+// Phones without an international prefix are parsed as national numbers of defaultRegion, which may be empty.
+// Of each group of duplicates, the best-formatted phone is kept at the position of the group's first phone: phones
+// with an extension over those without, with a country code over those without, valid ones over invalid ones, then
+// grouped digits over bare ones.
+func Dedupe(phones []string, defaultRegion string) []string {
+	var groups [][]string
+
+	for _, phone := range phones {
+		if strings.TrimSpace(phone) == "" {
+			continue
+		}
+
+		found := false
+
+		for i, group := range groups {
+			if matchesAll(group, phone, defaultRegion) {
+				groups[i] = append(group, phone)
+				found = true
+
+				break
+			}
+		}
+
+		if !found {
+			groups = append(groups, []string{phone})
+		}
+	}
+
+	deduped := make([]string, 0, len(groups))
+
+	for _, group := range groups {
+		best := group[0]
+		for _, phone := range group[1:] {
+			if formattingScore(phone, defaultRegion) > formattingScore(best, defaultRegion) {
+				best = phone
+			}
+		}
+
+		deduped = append(deduped, best)
+	}
+
+	return deduped
+}
+
+// matchesAll reports whether phone matches each of phones at least with MatchNationalNumber.
+func matchesAll(phones []string, phone, region string) bool {
+	for _, other := range phones {
+		if matchInRegion(other, phone, region) < MatchNationalNumber {
+			return false
+		}
+	}
+
+	return true
+}
+
+func matchInRegion(a, b, region string) MatchStrength {
+	numberA, errA := Parse(a, region)
+	numberB, errB := Parse(b, region)
+
+	switch {
+	case errA == nil && errB == nil:
+		if numberA.Extension != "" && numberB.Extension != "" && numberA.Extension != numberB.Extension {
+			return MatchNone
+		}
+
+		if numberA.E164 == numberB.E164 {
+			return MatchExact
+		}
+
+		if numberA.CountryCode != numberB.CountryCode {
+			return MatchNone
+		}
+
+		return matchNationalNumbers(numberA.NationalNumber, numberB.NationalNumber)
+	case errA == nil:
+		return matchRawNationalNumber(numberA, b)
+	case errB == nil:
+		return matchRawNationalNumber(numberB, a)
+	}
+
+	digitsA, _, errA := normalizeDigits(a)
+	digitsB, _, errB := normalizeDigits(b)
+
+	if errA != nil || errB != nil {
+		return MatchNone
+	}
+
+	return matchNationalNumbers(digitsA, digitsB)
+}
+
+// matchRawNationalNumber compares a parsed number with a raw one whose country is unknown.
+func matchRawNationalNumber(number PhoneNumber, raw string) MatchStrength {
+	digits, _, err := normalizeDigits(raw)
+	if err != nil {
+		return MatchNone
+	}
+
+	return matchNationalNumbers(number.NationalNumber, stripNationalPrefix(digits, regionMetadataByRegion[number.Region]))
+}
+
+func matchNationalNumbers(a, b string) MatchStrength {
+	switch {
+	case a == b:
+		return MatchNationalNumber
+	case min(len(a), len(b)) < minShortMatchDigits:
+		return MatchNone
+	case strings.HasSuffix(a, b), strings.HasSuffix(b, a):
+		return MatchShortNumber
+	default:
+		return MatchNone
+	}
+}
+
+func formattingScore(phone, defaultRegion string) int {
+	score := 0
+
+	if number, err := Parse(phone, defaultRegion); err == nil && number.Extension != "" {
+		score += 8 // Keeps its extension
+	}
+
+	if _, err := Parse(phone, ""); err == nil {
+		score += 4 // Carries its country code
+	}
+
+	if number, err := Parse(phone, defaultRegion); err == nil && number.IsValid {
+		score += 2
+	}
+
+	if digits, _, err := normalizeDigits(phone); err == nil && digits != strings.TrimPrefix(strings.TrimSpace(phone), "+") {
+		score++ // Digits are grouped
+	}
+
+	return score
+}
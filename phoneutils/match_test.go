@@ -0,0 +1,95 @@
+package phoneutils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMatch(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		a        string
+		b        string
+		expected MatchStrength
+	}{
+		{"Same number written differently", "+44 20 7946 0000", "+44 (0)20 7946 0000", MatchExact},
+		{"International and national prefixes", "+33612345678", "0033 6 12 34 56 78", MatchExact},
+		{"Country code missing on one side", "+44 20 7946 0000", "020 7946 0000", MatchNationalNumber},
+		{"Country code missing on the other side", "020 7946 0000", "+44 20 7946 0000", MatchNationalNumber},
+		{"Country code missing on both sides", "020 7946 0000", "020-7946-0000", MatchNationalNumber},
+		{"Area code missing", "+44 20 7946 0000", "7946 0000", MatchShortNumber},
+		{"Different country codes", "+44 20 7946 0000", "+33 20 7946 0000", MatchNone},
+		{"Different numbers", "+33612345678", "+33612345679", MatchNone},
+		{"Suffix too short", "+33612345678", "678", MatchNone},
+		{"Not a number", "+33612345678", "unknown", MatchNone},
+		{"Same extension", "+33142685300 ext. 12", "+33 1 42 68 53 00 x12", MatchExact},
+		{"Extension missing on one side", "+33142685300 ext. 12", "+33 1 42 68 53 00", MatchExact},
+		{"Different extensions", "+33142685300 ext. 12", "+33142685300 ext. 13", MatchNone},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tt.expected, Match(tt.a, tt.b))
+		})
+	}
+}
+
+func TestDedupe(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		phones        []string
+		defaultRegion string
+		expected      []string
+	}{
+		{
+			name:     "Given national and international forms should keep the international one",
+			phones:   []string{"020 7946 0000", "0612345678", "+44 20 7946 0000", "+33 6 12 34 56 78"},
+			expected: []string{"+44 20 7946 0000", "+33 6 12 34 56 78"},
+		},
+		{
+			name:          "Given default region should merge national numbers with international ones",
+			phones:        []string{"06 12 34 56 78", "+33612345678", "01 42 68 53 00"},
+			defaultRegion: "FR",
+			expected:      []string{"+33612345678", "01 42 68 53 00"},
+		},
+		{
+			name:     "Given same E.164 should prefer grouped digits",
+			phones:   []string{"+33612345678", "+33 6 12 34 56 78"},
+			expected: []string{"+33 6 12 34 56 78"},
+		},
+		{
+			name:     "Given different extensions should keep each extension",
+			phones:   []string{"+44 20 7946 0000", "+44 20 7946 0000 ext 1", "+44 20 7946 0000 ext 2"},
+			expected: []string{"+44 20 7946 0000 ext 1", "+44 20 7946 0000 ext 2"},
+		},
+		{
+			name:     "Given short number match should keep both",
+			phones:   []string{"+44 20 7946 0000", "7946 0000"},
+			expected: []string{"+44 20 7946 0000", "7946 0000"},
+		},
+		{
+			name:     "Given empty phones should skip them",
+			phones:   []string{"", " ", "+33612345678"},
+			expected: []string{"+33612345678"},
+		},
+		{
+			name:     "Given no phones should return empty slice",
+			expected: []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tt.expected, Dedupe(tt.phones, tt.defaultRegion))
+		})
+	}
+}
@@ -0,0 +1,578 @@
+package phoneutils
+
+import "regexp"
+
+// regionMetadata describes the numbering plan of a single region.
+type regionMetadata struct {
+	countryCode         int
+	internationalPrefix string // Prefix dialled to call abroad, e.g. "00" or "011"
+	nationalPrefix      string // Trunk prefix dialled before national numbers, e.g. "0"
+	possibleLengths     []int  // Possible lengths of the national significant number
+	pattern             *regexp.Regexp
+	types               []typePattern  // Checked in order, the first matching pattern gives the number type
+	formats             []numberFormat // Checked in order, the first matching pattern groups the number
+}
+
+type typePattern struct {
+	phoneType PhoneType
+	pattern   *regexp.Regexp
+}
+
+// numberFormat groups the digits of national numbers matching pattern, using regexp replacement templates.
+type numberFormat struct {
+	pattern       *regexp.Regexp
+	international string // Template used after the country code, e.g. "$1 $2 $3 $4 $5"
+	national      string // Template including the national prefix when dialled, e.g. "0$1 $2 $3 $4 $5"
+}
+
+// nationalPattern compiles a pattern which must match the whole national significant number.
+func nationalPattern(pattern string) *regexp.Regexp {
+	return regexp.MustCompile(`^(?:` + pattern + `)$`)
+}
+
+// countryCodeRegions maps country calling codes to the regions using them, main region first.
+var countryCodeRegions = map[int][]string{
+	1: {"US", "CA"}, 7: {"RU", "KZ"}, 20: {"EG"}, 27: {"ZA"}, 30: {"GR"}, 31: {"NL"}, 32: {"BE"}, 33: {"FR"}, 34: {"ES"},
+	36: {"HU"}, 39: {"IT", "VA"}, 40: {"RO"}, 41: {"CH"}, 43: {"AT"}, 44: {"GB"}, 45: {"DK"}, 46: {"SE"}, 47: {"NO"},
+	48: {"PL"}, 49: {"DE"}, 51: {"PE"}, 52: {"MX"}, 53: {"CU"}, 54: {"AR"}, 55: {"BR"}, 56: {"CL"}, 57: {"CO"},
+	58: {"VE"}, 60: {"MY"}, 61: {"AU"}, 62: {"ID"}, 63: {"PH"}, 64: {"NZ"}, 65: {"SG"}, 66: {"TH"}, 81: {"JP"},
+	82: {"KR"}, 84: {"VN"}, 86: {"CN"}, 90: {"TR"}, 91: {"IN"}, 92: {"PK"}, 93: {"AF"}, 94: {"LK"}, 95: {"MM"},
+	98: {"IR"}, 211: {"SS"}, 212: {"MA"}, 213: {"DZ"}, 216: {"TN"}, 218: {"LY"}, 220: {"GM"}, 221: {"SN"},
+	222: {"MR"}, 223: {"ML"}, 224: {"GN"}, 225: {"CI"}, 226: {"BF"}, 227: {"NE"}, 228: {"TG"}, 229: {"BJ"},
+	230: {"MU"}, 231: {"LR"}, 232: {"SL"}, 233: {"GH"}, 234: {"NG"}, 235: {"TD"}, 236: {"CF"}, 237: {"CM"},
+	238: {"CV"}, 239: {"ST"}, 240: {"GQ"}, 241: {"GA"}, 242: {"CG"}, 243: {"CD"}, 244: {"AO"}, 245: {"GW"},
+	246: {"IO"}, 248: {"SC"}, 249: {"SD"}, 250: {"RW"}, 251: {"ET"}, 252: {"SO"}, 253: {"DJ"}, 254: {"KE"},
+	255: {"TZ"}, 256: {"UG"}, 257: {"BI"}, 258: {"MZ"}, 260: {"ZM"}, 261: {"MG"}, 262: {"RE"}, 263: {"ZW"},
+	264: {"NA"}, 265: {"MW"}, 266: {"LS"}, 267: {"BW"}, 268: {"SZ"}, 269: {"KM"}, 290: {"SH"}, 291: {"ER"},
+	297: {"AW"}, 298: {"FO"}, 299: {"GL"}, 350: {"GI"}, 351: {"PT"}, 352: {"LU"}, 353: {"IE"}, 354: {"IS"},
+	355: {"AL"}, 356: {"MT"}, 357: {"CY"}, 358: {"FI"}, 359: {"BG"}, 370: {"LT"}, 371: {"LV"}, 372: {"EE"},
+	373: {"MD"}, 374: {"AM"}, 375: {"BY"}, 376: {"AD"}, 377: {"MC"}, 378: {"SM"}, 380: {"UA"}, 381: {"RS"},
+	382: {"ME"}, 383: {"XK"}, 385: {"HR"}, 386: {"SI"}, 387: {"BA"}, 389: {"MK"}, 420: {"CZ"}, 421: {"SK"},
+	423: {"LI"}, 500: {"FK"}, 501: {"BZ"}, 502: {"GT"}, 503: {"SV"}, 504: {"HN"}, 505: {"NI"}, 506: {"CR"},
+	507: {"PA"}, 508: {"PM"}, 509: {"HT"}, 590: {"GP"}, 591: {"BO"}, 592: {"GY"}, 593: {"EC"}, 594: {"GF"},
+	595: {"PY"}, 596: {"MQ"}, 597: {"SR"}, 598: {"UY"}, 599: {"CW"}, 670: {"TL"}, 672: {"NF"}, 673: {"BN"},
+	674: {"NR"}, 675: {"PG"}, 676: {"TO"}, 677: {"SB"}, 678: {"VU"}, 679: {"FJ"}, 680: {"PW"}, 681: {"WF"},
+	682: {"CK"}, 683: {"NU"}, 685: {"WS"}, 686: {"KI"}, 687: {"NC"}, 688: {"TV"}, 689: {"PF"}, 690: {"TK"},
+	691: {"FM"}, 692: {"MH"}, 850: {"KP"}, 852: {"HK"}, 853: {"MO"}, 855: {"KH"}, 856: {"LA"}, 880: {"BD"},
+	886: {"TW"}, 960: {"MV"}, 961: {"LB"}, 962: {"JO"}, 963: {"SY"}, 964: {"IQ"}, 965: {"KW"}, 966: {"SA"},
+	967: {"YE"}, 968: {"OM"}, 970: {"PS"}, 971: {"AE"}, 972: {"IL"}, 973: {"BH"}, 974: {"QA"}, 975: {"BT"},
+	976: {"MN"}, 977: {"NP"}, 992: {"TJ"}, 993: {"TM"}, 994: {"AZ"}, 995: {"GE"}, 996: {"KG"}, 998: {"UZ"},
+}
+
+// regionMetadataByRegion holds the numbering plans of the regions we validate numbers for.
+// Regions missing from this map can still be parsed, but their numbers are never reported as valid.
+var regionMetadataByRegion = map[string]*regionMetadata{
+	"AE": {
+		countryCode:         971,
+		internationalPrefix: "00",
+		nationalPrefix:      "0",
+		possibleLengths:     []int{8, 9},
+		pattern:             nationalPattern(`[2-79]\d{7,8}|800\d{2,9}`),
+		types: []typePattern{
+			{PhoneTypeTollFree, nationalPattern(`800\d{2,9}`)},
+			{PhoneTypePremium, nationalPattern(`900[02]\d{5}`)},
+			{PhoneTypeMobile, nationalPattern(`5[024-68]\d{7}`)},
+			{PhoneTypeFixedLine, nationalPattern(`[2-4679][2-8]\d{6}`)},
+		},
+		formats: []numberFormat{
+			{nationalPattern(`(5\d)(\d{3})(\d{4})`), "$1 $2 $3", "0$1 $2 $3"},
+			{nationalPattern(`([2-4679])(\d{3})(\d{4})`), "$1 $2 $3", "0$1 $2 $3"},
+			{nationalPattern(`(800)(\d{2,9})`), "$1 $2", "$1 $2"},
+		},
+	},
+	"AT": {
+		countryCode:         43,
+		internationalPrefix: "00",
+		nationalPrefix:      "0",
+		possibleLengths:     []int{4, 5, 6, 7, 8, 9, 10, 11, 12, 13},
+		pattern:             nationalPattern(`[1-9]\d{3,12}`),
+		types: []typePattern{
+			{PhoneTypeTollFree, nationalPattern(`800\d{6,10}`)},
+			{PhoneTypePremium, nationalPattern(`(?:8[69][01]|9[0-3]0)\d{6,10}`)},
+			{PhoneTypeMobile, nationalPattern(`6(?:5[0-3579]|6[013-9]|[7-9]\d)\d{4,10}`)},
+			{PhoneTypeVoIP, nationalPattern(`5(?:0[1-9]|17)\d{2,10}|5[79]\d{3,11}|720\d{6,10}`)},
+			{PhoneTypeFixedLine, nationalPattern(`1\d{3,12}|[2-7]\d{4,12}`)},
+		},
+		formats: []numberFormat{
+			{nationalPattern(`(1)(\d{3,12})`), "$1 $2", "0$1 $2"},
+			{nationalPattern(`(6\d{2})(\d{4,10})`), "$1 $2", "0$1 $2"},
+			{nationalPattern(`(\d{3,4})(\d{3,9})`), "$1 $2", "0$1 $2"},
+		},
+	},
+	"AU": {
+		countryCode:         61,
+		internationalPrefix: "0011",
+		nationalPrefix:      "0",
+		possibleLengths:     []int{9, 10},
+		pattern:             nationalPattern(`[2-478]\d{8}|1[389]00\d{6}`),
+		types: []typePattern{
+			{PhoneTypeTollFree, nationalPattern(`180(?:0\d{3}|2)\d{3}`)},
+			{PhoneTypePremium, nationalPattern(`190[0-26]\d{6}`)},
+			{PhoneTypeMobile, nationalPattern(`4\d{8}`)},
+			{PhoneTypeFixedLine, nationalPattern(`[2378]\d{8}`)},
+		},
+		formats: []numberFormat{
+			{nationalPattern(`(4\d{2})(\d{3})(\d{3})`), "$1 $2 $3", "0$1 $2 $3"},
+			{nationalPattern(`([2378])(\d{4})(\d{4})`), "$1 $2 $3", "(0$1) $2 $3"},
+			{nationalPattern(`(1[389]00)(\d{3})(\d{3})`), "$1 $2 $3", "$1 $2 $3"},
+		},
+	},
+	"BE": {
+		countryCode:         32,
+		internationalPrefix: "00",
+		nationalPrefix:      "0",
+		possibleLengths:     []int{8, 9},
+		pattern:             nationalPattern(`4\d{8}|[1-9]\d{7}`),
+		types: []typePattern{
+			{PhoneTypeTollFree, nationalPattern(`800\d{5}`)},
+			{PhoneTypePremium, nationalPattern(`(?:70|90)\d{6}`)},
+			{PhoneTypeMobile, nationalPattern(`4[5-9]\d{7}`)},
+			{PhoneTypeFixedLine, nationalPattern(`[1-9]\d{7}`)},
+		},
+		formats: []numberFormat{
+			{nationalPattern(`(4\d{2})(\d{2})(\d{2})(\d{2})`), "$1 $2 $3 $4", "0$1 $2 $3 $4"},
+			{nationalPattern(`([2349])(\d{3})(\d{2})(\d{2})`), "$1 $2 $3 $4", "0$1 $2 $3 $4"},
+			{nationalPattern(`(\d{2})(\d{2})(\d{2})(\d{2})`), "$1 $2 $3 $4", "0$1 $2 $3 $4"},
+		},
+	},
+	"BR": {
+		countryCode:         55,
+		internationalPrefix: "00",
+		nationalPrefix:      "0",
+		possibleLengths:     []int{9, 10, 11},
+		pattern:             nationalPattern(`[1-9][1-9]9\d{8}|[1-9][1-9][2-5]\d{7}|[359]00\d{6,7}|800\d{6,7}`),
+		types: []typePattern{
+			{PhoneTypeTollFree, nationalPattern(`800\d{6,7}`)},
+			{PhoneTypePremium, nationalPattern(`[359]00\d{6,7}`)},
+			{PhoneTypeMobile, nationalPattern(`[1-9][1-9]9\d{8}`)},
+			{PhoneTypeFixedLine, nationalPattern(`[1-9][1-9][2-5]\d{7}`)},
+		},
+		formats: []numberFormat{
+			{nationalPattern(`([359]00|800)(\d{2,3})(\d{4})`), "$1 $2 $3", "0$1 $2 $3"},
+			{nationalPattern(`(\d{2})(\d{4,5})(\d{4})`), "$1 $2-$3", "($1) $2-$3"},
+		},
+	},
+	"CA": {
+		countryCode:         1,
+		internationalPrefix: "011",
+		nationalPrefix:      "1",
+		possibleLengths:     []int{10},
+		pattern: nationalPattern(`(?:204|226|236|249|250|263|289|306|343|354|365|367|368|382|403|416|418|428|431|437|438|` +
+			`450|468|474|506|514|519|548|579|581|584|587|604|613|639|647|672|683|705|709|742|753|778|780|782|807|819|825|` +
+			`867|873|879|902|905)[2-9]\d{6}`),
+		types: []typePattern{
+			{PhoneTypeFixedLineOrMobile, nationalPattern(`[2-9]\d{2}[2-9]\d{6}`)},
+		},
+		formats: []numberFormat{
+			{nationalPattern(`(\d{3})(\d{3})(\d{4})`), "$1-$2-$3", "($1) $2-$3"},
+		},
+	},
+	"CH": {
+		countryCode:         41,
+		internationalPrefix: "00",
+		nationalPrefix:      "0",
+		possibleLengths:     []int{9},
+		pattern:             nationalPattern(`[2-9]\d{8}`),
+		types: []typePattern{
+			{PhoneTypeTollFree, nationalPattern(`800\d{6}`)},
+			{PhoneTypePremium, nationalPattern(`90[016]\d{6}`)},
+			{PhoneTypePager, nationalPattern(`74\d{7}`)},
+			{PhoneTypeMobile, nationalPattern(`7[5-9]\d{7}`)},
+			{PhoneTypeFixedLine, nationalPattern(`(?:2[12467]|3[1-4]|4[134]|5[12568]|6[12]|[7-9]1)\d{7}`)},
+		},
+		formats: []numberFormat{
+			{nationalPattern(`(8\d{2}|90\d)(\d{3})(\d{3})`), "$1 $2 $3", "0$1 $2 $3"},
+			{nationalPattern(`(\d{2})(\d{3})(\d{2})(\d{2})`), "$1 $2 $3 $4", "0$1 $2 $3 $4"},
+		},
+	},
+	"DE": {
+		countryCode:         49,
+		internationalPrefix: "00",
+		nationalPrefix:      "0",
+		possibleLengths:     []int{6, 7, 8, 9, 10, 11, 12, 13},
+		pattern:             nationalPattern(`[1-9]\d{5,12}`),
+		types: []typePattern{
+			{PhoneTypeTollFree, nationalPattern(`800\d{7,12}`)},
+			{PhoneTypePremium, nationalPattern(`900\d{7,8}`)},
+			{PhoneTypeVoIP, nationalPattern(`32\d{9,11}`)},
+			{PhoneTypeMobile, nationalPattern(`1(?:5[0-25-9]\d{8}|6[023]\d{7,8}|7\d{8,9})`)},
+			{PhoneTypePager, nationalPattern(`16(?:4\d{1,10}|[89]\d{1,11})`)},
+			{PhoneTypeFixedLine, nationalPattern(`[2-9]\d{5,11}`)},
+		},
+		formats: []numberFormat{
+			{nationalPattern(`(1[5-7]\d)(\d{7,8})`), "$1 $2", "0$1 $2"},
+			{nationalPattern(`(30|40|69|89)(\d{3,9})`), "$1 $2", "0$1 $2"},
+			{nationalPattern(`(\d{3})(\d{3,10})`), "$1 $2", "0$1 $2"},
+		},
+	},
+	"DK": {
+		countryCode:         45,
+		internationalPrefix: "00",
+		possibleLengths:     []int{8},
+		pattern:             nationalPattern(`[2-9]\d{7}`),
+		types: []typePattern{
+			{PhoneTypeTollFree, nationalPattern(`80\d{6}`)},
+			{PhoneTypePremium, nationalPattern(`90\d{6}`)},
+			{PhoneTypeFixedLineOrMobile, nationalPattern(`[2-7]\d{7}|8[126-9]\d{6}|9[1-46-9]\d{6}`)},
+		},
+		formats: []numberFormat{
+			{nationalPattern(`(\d{2})(\d{2})(\d{2})(\d{2})`), "$1 $2 $3 $4", "$1 $2 $3 $4"},
+		},
+	},
+	"ES": {
+		countryCode:         34,
+		internationalPrefix: "00",
+		possibleLengths:     []int{9},
+		pattern:             nationalPattern(`[5-9]\d{8}`),
+		types: []typePattern{
+			{PhoneTypeTollFree, nationalPattern(`(?:800|900)\d{6}`)},
+			{PhoneTypePremium, nationalPattern(`(?:80[1-9]|90[1-9])\d{6}`)},
+			{PhoneTypeVoIP, nationalPattern(`51\d{7}`)},
+			{PhoneTypeMobile, nationalPattern(`(?:6\d|7[1-9])\d{7}`)},
+			{PhoneTypeFixedLine, nationalPattern(`[89][1-8]\d{7}`)},
+		},
+		formats: []numberFormat{
+			{nationalPattern(`(\d{3})(\d{2})(\d{2})(\d{2})`), "$1 $2 $3 $4", "$1 $2 $3 $4"},
+		},
+	},
+	"FI": {
+		countryCode:         358,
+		internationalPrefix: "00",
+		nationalPrefix:      "0",
+		possibleLengths:     []int{5, 6, 7, 8, 9, 10, 11, 12},
+		pattern:             nationalPattern(`[1-9]\d{4,11}`),
+		types: []typePattern{
+			{PhoneTypeTollFree, nationalPattern(`800\d{4,6}`)},
+			{PhoneTypePremium, nationalPattern(`[67]00\d{5,6}`)},
+			{PhoneTypeMobile, nationalPattern(`(?:4\d|50)\d{4,8}`)},
+			{PhoneTypeFixedLine, nationalPattern(`[1-35689]\d{4,11}`)},
+		},
+		formats: []numberFormat{
+			{nationalPattern(`(4\d|50)(\d{4,8})`), "$1 $2", "0$1 $2"},
+			{nationalPattern(`(9)(\d{4,10})`), "$1 $2", "0$1 $2"},
+			{nationalPattern(`(\d{2,3})(\d{3,9})`), "$1 $2", "0$1 $2"},
+		},
+	},
+	"FR": {
+		countryCode:         33,
+		internationalPrefix: "00",
+		nationalPrefix:      "0",
+		possibleLengths:     []int{9},
+		pattern:             nationalPattern(`[1-9]\d{8}`),
+		types: []typePattern{
+			{PhoneTypeTollFree, nationalPattern(`80[0-5]\d{6}`)},
+			{PhoneTypePremium, nationalPattern(`8[1-9]\d{7}`)},
+			{PhoneTypeVoIP, nationalPattern(`9\d{8}`)},
+			{PhoneTypeMobile, nationalPattern(`[67]\d{8}`)},
+			{PhoneTypeFixedLine, nationalPattern(`[1-5]\d{8}`)},
+		},
+		formats: []numberFormat{
+			{nationalPattern(`(\d)(\d{2})(\d{2})(\d{2})(\d{2})`), "$1 $2 $3 $4 $5", "0$1 $2 $3 $4 $5"},
+		},
+	},
+	"GB": {
+		countryCode:         44,
+		internationalPrefix: "00",
+		nationalPrefix:      "0",
+		possibleLengths:     []int{9, 10},
+		pattern:             nationalPattern(`[1-357-9]\d{8,9}`),
+		types: []typePattern{
+			{PhoneTypeTollFree, nationalPattern(`80[08]\d{7}|800\d{6}`)},
+			{PhoneTypePremium, nationalPattern(`9[018]\d{8}`)},
+			{PhoneTypeVoIP, nationalPattern(`56\d{8}`)},
+			{PhoneTypePager, nationalPattern(`76(?:0[0-2]|2[356]|34|4[01347]|5[49]|6[0-369]|77|81|9[139])\d{6}`)},
+			{PhoneTypeMobile, nationalPattern(`7[1-57-9]\d{8}`)},
+			{PhoneTypeFixedLine, nationalPattern(`[12]\d{8,9}`)},
+		},
+		formats: []numberFormat{
+			{nationalPattern(`(7\d{3})(\d{6})`), "$1 $2", "0$1 $2"},
+			{nationalPattern(`(2\d)(\d{4})(\d{4})`), "$1 $2 $3", "0$1 $2 $3"},
+			{nationalPattern(`(1\d1|11\d)(\d{3})(\d{4})`), "$1 $2 $3", "0$1 $2 $3"},
+			{nationalPattern(`(1\d{3})(\d{5,6})`), "$1 $2", "0$1 $2"},
+			{nationalPattern(`([3589]\d{2})(\d{3})(\d{3,4})`), "$1 $2 $3", "0$1 $2 $3"},
+		},
+	},
+	"IE": {
+		countryCode:         353,
+		internationalPrefix: "00",
+		nationalPrefix:      "0",
+		possibleLengths:     []int{7, 8, 9, 10},
+		pattern:             nationalPattern(`[124-9]\d{6,9}`),
+		types: []typePattern{
+			{PhoneTypeTollFree, nationalPattern(`1800\d{6}`)},
+			{PhoneTypePremium, nationalPattern(`15(?:1[2-8]|[2-8]0|9[089])\d{6}`)},
+			{PhoneTypeVoIP, nationalPattern(`76\d{7}`)},
+			{PhoneTypeMobile, nationalPattern(`8[3-9]\d{7}`)},
+			{PhoneTypeFixedLine, nationalPattern(`[124-79]\d{6,8}`)},
+		},
+		formats: []numberFormat{
+			{nationalPattern(`(1800)(\d{3})(\d{3})`), "$1 $2 $3", "$1 $2 $3"},
+			{nationalPattern(`(8\d)(\d{3})(\d{4})`), "$1 $2 $3", "0$1 $2 $3"},
+			{nationalPattern(`(1)(\d{3,4})(\d{4})`), "$1 $2 $3", "0$1 $2 $3"},
+			{nationalPattern(`(\d{2})(\d{3})(\d{3,4})`), "$1 $2 $3", "0$1 $2 $3"},
+		},
+	},
+	"IL": {
+		countryCode:         972,
+		internationalPrefix: "00",
+		nationalPrefix:      "0",
+		possibleLengths:     []int{8, 9, 10},
+		pattern:             nationalPattern(`[2-489]\d{7}|[57]\d{8}|1[89]00\d{6}`),
+		types: []typePattern{
+			{PhoneTypeTollFree, nationalPattern(`1800\d{6}`)},
+			{PhoneTypePremium, nationalPattern(`1900\d{6}`)},
+			{PhoneTypeMobile, nationalPattern(`5\d{8}`)},
+			{PhoneTypeVoIP, nationalPattern(`7[2-9]\d{7}`)},
+			{PhoneTypeFixedLine, nationalPattern(`[2-489]\d{7}`)},
+		},
+		formats: []numberFormat{
+			{nationalPattern(`(1[89]00)(\d{3})(\d{3})`), "$1-$2-$3", "$1-$2-$3"},
+			{nationalPattern(`([57]\d)(\d{3})(\d{4})`), "$1-$2-$3", "0$1-$2-$3"},
+			{nationalPattern(`([2-489])(\d{3})(\d{4})`), "$1-$2-$3", "0$1-$2-$3"},
+		},
+	},
+	"IN": {
+		countryCode:         91,
+		internationalPrefix: "00",
+		nationalPrefix:      "0",
+		possibleLengths:     []int{10, 11},
+		pattern:             nationalPattern(`[1-9]\d{9}|18[06]0\d{7}`),
+		types: []typePattern{
+			{PhoneTypeTollFree, nationalPattern(`1800\d{6,7}`)},
+			{PhoneTypeMobile, nationalPattern(`[6-9]\d{9}`)},
+			{PhoneTypeFixedLine, nationalPattern(`[1-5]\d{9}`)},
+		},
+		formats: []numberFormat{
+			{nationalPattern(`(1800)(\d{3})(\d{3,4})`), "$1 $2 $3", "$1 $2 $3"},
+			{nationalPattern(`(\d{5})(\d{5})`), "$1 $2", "0$1 $2"},
+		},
+	},
+	"IT": {
+		countryCode:         39,
+		internationalPrefix: "00",
+		possibleLengths:     []int{6, 7, 8, 9, 10, 11},
+		pattern:             nationalPattern(`0\d{5,10}|3\d{8,9}|8[09]\d{4,7}|55\d{8}`),
+		types: []typePattern{
+			{PhoneTypeTollFree, nationalPattern(`80(?:0\d{3}|3)\d{3}`)},
+			{PhoneTypePremium, nationalPattern(`89\d{4,7}`)},
+			{PhoneTypeVoIP, nationalPattern(`55\d{8}`)},
+			{PhoneTypeMobile, nationalPattern(`3\d{8,9}`)},
+			{PhoneTypeFixedLine, nationalPattern(`0\d{5,10}`)},
+		},
+		formats: []numberFormat{
+			{nationalPattern(`(3\d{2})(\d{3})(\d{3,4})`), "$1 $2 $3", "$1 $2 $3"},
+			{nationalPattern(`(0[26])(\d{4})(\d{2,6})`), "$1 $2 $3", "$1 $2 $3"},
+			{nationalPattern(`(0\d{2})(\d{3,4})(\d{2,4})`), "$1 $2 $3", "$1 $2 $3"},
+			{nationalPattern(`(8[09]\d)(\d{3,6})`), "$1 $2", "$1 $2"},
+		},
+	},
+	"JP": {
+		countryCode:         81,
+		internationalPrefix: "010",
+		nationalPrefix:      "0",
+		possibleLengths:     []int{9, 10},
+		pattern:             nationalPattern(`[1-9]\d{8,9}`),
+		types: []typePattern{
+			{PhoneTypeTollFree, nationalPattern(`120\d{6}|800\d{7}`)},
+			{PhoneTypePremium, nationalPattern(`990\d{6}`)},
+			{PhoneTypeVoIP, nationalPattern(`50\d{8}`)},
+			{PhoneTypePager, nationalPattern(`20\d{8}`)},
+			{PhoneTypeMobile, nationalPattern(`[7-9]0\d{8}`)},
+			{PhoneTypeFixedLine, nationalPattern(`[1-9]\d{8}`)},
+		},
+		formats: []numberFormat{
+			{nationalPattern(`([7-9]0)(\d{4})(\d{4})`), "$1-$2-$3", "0$1-$2-$3"},
+			{nationalPattern(`(120)(\d{3})(\d{3})`), "$1-$2-$3", "0$1-$2-$3"},
+			{nationalPattern(`([36])(\d{4})(\d{4})`), "$1-$2-$3", "0$1-$2-$3"},
+			{nationalPattern(`(\d{2})(\d{3})(\d{4})`), "$1-$2-$3", "0$1-$2-$3"},
+		},
+	},
+	"KZ": {
+		countryCode:         7,
+		internationalPrefix: "810",
+		nationalPrefix:      "8",
+		possibleLengths:     []int{10},
+		pattern:             nationalPattern(`(?:33|7\d)\d{8}`),
+		types: []typePattern{
+			{PhoneTypeMobile, nationalPattern(`7(?:0[0-25-8]|47|6[02-4]|7[15-8]|85)\d{7}`)},
+			{PhoneTypeFixedLine, nationalPattern(`(?:33|7\d)\d{8}`)},
+		},
+		formats: []numberFormat{
+			{nationalPattern(`(\d{3})(\d{3})(\d{2})(\d{2})`), "$1 $2 $3 $4", "8 ($1) $2-$3-$4"},
+		},
+	},
+	"MX": {
+		countryCode:         52,
+		internationalPrefix: "00",
+		possibleLengths:     []int{10},
+		pattern:             nationalPattern(`[2-9]\d{9}`),
+		types: []typePattern{
+			{PhoneTypeTollFree, nationalPattern(`8(?:00|88)\d{7}`)},
+			{PhoneTypePremium, nationalPattern(`900\d{7}`)},
+			{PhoneTypeFixedLineOrMobile, nationalPattern(`[2-9]\d{9}`)},
+		},
+		formats: []numberFormat{
+			{nationalPattern(`(33|55|81)(\d{4})(\d{4})`), "$1 $2 $3", "$1 $2 $3"},
+			{nationalPattern(`(\d{3})(\d{3})(\d{4})`), "$1 $2 $3", "$1 $2 $3"},
+		},
+	},
+	"NL": {
+		countryCode:         31,
+		internationalPrefix: "00",
+		nationalPrefix:      "0",
+		possibleLengths:     []int{7, 8, 9, 10},
+		pattern:             nationalPattern(`[1-9]\d{8}|[89]0\d{4,8}`),
+		types: []typePattern{
+			{PhoneTypeTollFree, nationalPattern(`800\d{4,7}`)},
+			{PhoneTypePremium, nationalPattern(`90[069]\d{4,7}`)},
+			{PhoneTypeVoIP, nationalPattern(`(?:85|91)\d{7}`)},
+			{PhoneTypePager, nationalPattern(`66\d{7}`)},
+			{PhoneTypeMobile, nationalPattern(`6[1-58]\d{7}`)},
+			{PhoneTypeFixedLine, nationalPattern(`(?:1[0-8]|2[0-46-9]|3[0-8]|4[0-8]|5\d|7\d)\d{7}`)},
+		},
+		formats: []numberFormat{
+			{nationalPattern(`(6)(\d{8})`), "$1 $2", "0$1 $2"},
+			{nationalPattern(`(800|90\d)(\d{4,7})`), "$1 $2", "0$1 $2"},
+			{nationalPattern(`([1-57-9]\d)(\d{3})(\d{4})`), "$1 $2 $3", "0$1 $2 $3"},
+		},
+	},
+	"NO": {
+		countryCode:         47,
+		internationalPrefix: "00",
+		possibleLengths:     []int{8},
+		pattern:             nationalPattern(`[2-9]\d{7}`),
+		types: []typePattern{
+			{PhoneTypeTollFree, nationalPattern(`80[01]\d{5}`)},
+			{PhoneTypePremium, nationalPattern(`82[09]\d{5}`)},
+			{PhoneTypeVoIP, nationalPattern(`85[0-5]\d{5}`)},
+			{PhoneTypeMobile, nationalPattern(`[49]\d{7}`)},
+			{PhoneTypeFixedLine, nationalPattern(`[235-7]\d{7}`)},
+		},
+		formats: []numberFormat{
+			{nationalPattern(`([49]\d{2})(\d{2})(\d{3})`), "$1 $2 $3", "$1 $2 $3"},
+			{nationalPattern(`(\d{2})(\d{2})(\d{2})(\d{2})`), "$1 $2 $3 $4", "$1 $2 $3 $4"},
+		},
+	},
+	"PL": {
+		countryCode:         48,
+		internationalPrefix: "00",
+		possibleLengths:     []int{9},
+		pattern:             nationalPattern(`[1-9]\d{8}`),
+		types: []typePattern{
+			{PhoneTypeTollFree, nationalPattern(`800\d{6}`)},
+			{PhoneTypePremium, nationalPattern(`70[01346-8]\d{6}`)},
+			{PhoneTypeVoIP, nationalPattern(`39\d{7}`)},
+			{PhoneTypePager, nationalPattern(`64\d{7}`)},
+			{PhoneTypeMobile, nationalPattern(`(?:45|5[0137]|6[069]|7[2389]|88)\d{7}`)},
+			{PhoneTypeFixedLine, nationalPattern(`(?:1[2-8]|2[2-69]|3[2-4]|4[1-468]|5[24-689]|6[1-3578]|7[14-7]|8[1-79]|9[145])\d{7}`)},
+		},
+		formats: []numberFormat{
+			{nationalPattern(`((?:45|5[0137]|6[069]|7[2389]|88)\d)(\d{3})(\d{3})`), "$1 $2 $3", "$1 $2 $3"},
+			{nationalPattern(`(800|70\d)(\d{3})(\d{3})`), "$1 $2 $3", "$1 $2 $3"},
+			{nationalPattern(`(\d{2})(\d{3})(\d{2})(\d{2})`), "$1 $2 $3 $4", "$1 $2 $3 $4"},
+		},
+	},
+	"PT": {
+		countryCode:         351,
+		internationalPrefix: "00",
+		possibleLengths:     []int{9},
+		pattern:             nationalPattern(`[236-9]\d{8}`),
+		types: []typePattern{
+			{PhoneTypeTollFree, nationalPattern(`80[02]\d{6}`)},
+			{PhoneTypePremium, nationalPattern(`(?:6\d|7[01])\d{7}`)},
+			{PhoneTypeVoIP, nationalPattern(`30\d{7}`)},
+			{PhoneTypeMobile, nationalPattern(`9[1236]\d{7}`)},
+			{PhoneTypeFixedLine, nationalPattern(`2\d{8}`)},
+		},
+		formats: []numberFormat{
+			{nationalPattern(`(2[12])(\d{3})(\d{4})`), "$1 $2 $3", "$1 $2 $3"},
+			{nationalPattern(`(\d{3})(\d{3})(\d{3})`), "$1 $2 $3", "$1 $2 $3"},
+		},
+	},
+	"RU": {
+		countryCode:         7,
+		internationalPrefix: "810",
+		nationalPrefix:      "8",
+		possibleLengths:     []int{10},
+		pattern:             nationalPattern(`[3489]\d{9}`),
+		types: []typePattern{
+			{PhoneTypeTollFree, nationalPattern(`80[04]\d{7}`)},
+			{PhoneTypePremium, nationalPattern(`80[39]\d{7}`)},
+			{PhoneTypeMobile, nationalPattern(`9\d{9}`)},
+			{PhoneTypeFixedLine, nationalPattern(`[348]\d{9}`)},
+		},
+		formats: []numberFormat{
+			{nationalPattern(`(\d{3})(\d{3})(\d{2})(\d{2})`), "$1 $2-$3-$4", "8 ($1) $2-$3-$4"},
+		},
+	},
+	"SE": {
+		countryCode:         46,
+		internationalPrefix: "00",
+		nationalPrefix:      "0",
+		possibleLengths:     []int{6, 7, 8, 9, 10},
+		pattern:             nationalPattern(`[1-9]\d{5,9}`),
+		types: []typePattern{
+			{PhoneTypeTollFree, nationalPattern(`20\d{4,7}`)},
+			{PhoneTypePremium, nationalPattern(`9(?:00|39|44)\d{7}`)},
+			{PhoneTypeVoIP, nationalPattern(`75\d{5,8}`)},
+			{PhoneTypePager, nationalPattern(`74[02-9]\d{6}`)},
+			{PhoneTypeMobile, nationalPattern(`7[02369]\d{7}`)},
+			{PhoneTypeFixedLine, nationalPattern(`[1-689]\d{5,9}`)},
+		},
+		formats: []numberFormat{
+			{nationalPattern(`(7\d)(\d{3})(\d{2})(\d{2})`), "$1 $2 $3 $4", "0$1-$2 $3 $4"},
+			{nationalPattern(`(8)(\d{3})(\d{2})(\d{2})`), "$1 $2 $3 $4", "0$1-$2 $3 $4"},
+			{nationalPattern(`(\d{2})(\d{3})(\d{2})(\d{2})`), "$1 $2 $3 $4", "0$1-$2 $3 $4"},
+			{nationalPattern(`(\d{3})(\d{2,3})(\d{2})`), "$1 $2 $3", "0$1-$2 $3"},
+		},
+	},
+	"SG": {
+		countryCode:         65,
+		internationalPrefix: "000",
+		possibleLengths:     []int{8, 10, 11},
+		pattern:             nationalPattern(`[3689]\d{7}|1?800\d{7}|1900\d{7}`),
+		types: []typePattern{
+			{PhoneTypeTollFree, nationalPattern(`1?800\d{7}`)},
+			{PhoneTypePremium, nationalPattern(`1900\d{7}`)},
+			{PhoneTypeVoIP, nationalPattern(`3\d{7}`)},
+			{PhoneTypeMobile, nationalPattern(`[89]\d{7}`)},
+			{PhoneTypeFixedLine, nationalPattern(`6\d{7}`)},
+		},
+		formats: []numberFormat{
+			{nationalPattern(`(1?800|1900)(\d{3})(\d{4})`), "$1 $2 $3", "$1 $2 $3"},
+			{nationalPattern(`(\d{4})(\d{4})`), "$1 $2", "$1 $2"},
+		},
+	},
+	"US": {
+		countryCode:         1,
+		internationalPrefix: "011",
+		nationalPrefix:      "1",
+		possibleLengths:     []int{10},
+		pattern:             nationalPattern(`[2-9]\d{2}[2-9]\d{6}`),
+		types: []typePattern{
+			{PhoneTypeTollFree, nationalPattern(`8(?:00|33|44|55|66|77|88)[2-9]\d{6}`)},
+			{PhoneTypePremium, nationalPattern(`900[2-9]\d{6}`)},
+			{PhoneTypeFixedLineOrMobile, nationalPattern(`[2-9]\d{2}[2-9]\d{6}`)},
+		},
+		formats: []numberFormat{
+			{nationalPattern(`(\d{3})(\d{3})(\d{4})`), "$1-$2-$3", "($1) $2-$3"},
+		},
+	},
+	"ZA": {
+		countryCode:         27,
+		internationalPrefix: "00",
+		nationalPrefix:      "0",
+		possibleLengths:     []int{9},
+		pattern:             nationalPattern(`[1-8]\d{8}`),
+		types: []typePattern{
+			{PhoneTypeTollFree, nationalPattern(`80\d{7}`)},
+			{PhoneTypePremium, nationalPattern(`86[2-9]\d{6}|9[0-2]\d{7}`)},
+			{PhoneTypeVoIP, nationalPattern(`87\d{7}`)},
+			{PhoneTypeMobile, nationalPattern(`(?:6[0-5]|7[0-46-9]|8[1-5])\d{7}`)},
+			{PhoneTypeFixedLine, nationalPattern(`(?:1[0-8]|2[1-378]|3[1-69]|4\d|5[1346-8])\d{7}`)},
+		},
+		formats: []numberFormat{
+			{nationalPattern(`(\d{2})(\d{3})(\d{4})`), "$1 $2 $3", "0$1 $2 $3"},
+		},
+	},
+}
@@ -0,0 +1,52 @@
+package phoneutils
+
+// PhoneType is the kind of line a phone number is assigned to.
+type PhoneType string
+
+const (
+	PhoneTypeMobile            PhoneType = "mobile"
+	PhoneTypeFixedLine         PhoneType = "fixed_line"
+	PhoneTypeFixedLineOrMobile PhoneType = "fixed_line_or_mobile" // Regions such as US where both share the same ranges
+	PhoneTypeTollFree          PhoneType = "toll_free"
+	PhoneTypePremium           PhoneType = "premium"
+	PhoneTypeVoIP              PhoneType = "voip"
+	PhoneTypePager             PhoneType = "pager"
+	PhoneTypeUnknown           PhoneType = "unknown"
+)
+
+// NumberType parses number (see Parse) and classifies it using the prefix tables of its region.
+// Examples:
+//
+//	NumberType("06 12 34 56 78", "FR")   -> mobile
+//	NumberType("+44 7400 123456", "")    -> mobile
+//	NumberType("0800 123 4567", "GB")    -> toll_free
+//	NumberType("not a number", "FR")     -> unknown
+func NumberType(number, region string) PhoneType {
+	parsed, err := Parse(number, region)
+	if err != nil {
+		return PhoneTypeUnknown
+	}
+
+	return TypeOf(parsed)
+}
+
+// TypeOf classifies an already parsed number using the prefix tables of its region.
+func TypeOf(number PhoneNumber) PhoneType {
+	md, found := regionMetadataByRegion[number.Region]
+	if !found {
+		return PhoneTypeUnknown
+	}
+
+	for _, tp := range md.types {
+		if tp.pattern.MatchString(number.NationalNumber) {
+			return tp.phoneType
+		}
+	}
+
+	return PhoneTypeUnknown
+}
+
+// IsMobileType reports whether numbers of the given type may be assigned to a mobile line.
+func IsMobileType(phoneType PhoneType) bool {
+	return phoneType == PhoneTypeMobile || phoneType == PhoneTypeFixedLineOrMobile
+}
@@ -0,0 +1,44 @@
+package phoneutils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNumberType(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		number   string
+		region   string
+		expected PhoneType
+	}{
+		{"French mobile", "06 12 34 56 78", "FR", PhoneTypeMobile},
+		{"French fixed line", "+33 1 42 68 53 00", "", PhoneTypeFixedLine},
+		{"French toll-free", "0800 123 456", "FR", PhoneTypeTollFree},
+		{"French VoIP", "09 12 34 56 78", "FR", PhoneTypeVoIP},
+		{"UK mobile", "+44 7400 123456", "", PhoneTypeMobile},
+		{"UK fixed line", "020 7946 0000", "GB", PhoneTypeFixedLine},
+		{"UK toll-free", "0800 123 4567", "GB", PhoneTypeTollFree},
+		{"UK premium", "0906 123 4567", "GB", PhoneTypePremium},
+		{"UK pager", "07600 123456", "GB", PhoneTypePager},
+		{"German mobile", "+49 151 23456789", "", PhoneTypeMobile},
+		{"German fixed line", "030 1234567", "DE", PhoneTypeFixedLine},
+		{"Spanish mobile", "+34 612 34 56 78", "", PhoneTypeMobile},
+		{"US number", "(415) 555-2671", "US", PhoneTypeFixedLineOrMobile},
+		{"US toll-free", "+1 800 555 0199", "", PhoneTypeTollFree},
+		{"Region without metadata", "+86 138 0013 8000", "", PhoneTypeUnknown},
+		{"Invalid number", "1234567890", "FR", PhoneTypeUnknown},
+		{"Not a number", "not found", "FR", PhoneTypeUnknown},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tt.expected, NumberType(tt.number, tt.region))
+		})
+	}
+}
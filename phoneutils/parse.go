@@ -0,0 +1,250 @@
+package phoneutils
+
+import (
+	"errors"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+const (
+	minVanityDigits         = 3
+	maxVanityDigits         = 8 // Longer digit runs are whole numbers, followed by words like "cell"
+	minNationalNumberLength = 2
+	maxNationalNumberLength = 17
+	maxE164Length           = 15
+	minGenericNumberLength  = 4
+
+	// Extension written after a number, e.g. "ext. 42", "x42", "#42", "poste 42", "Durchwahl 42" or ";ext=42"
+	extensionPattern = `(?i)(?:\s*;\s*ext=|[\s,]*(?:(?:ext(?:ension)?|poste|durchwahl|dw|anexo|доб)\.?|x|#)\s*[:.]?\s*)(\d{1,7})#?`
+)
+
+var (
+	ErrNotANumber         = errors.New("not a phone number")
+	ErrInvalidCountryCode = errors.New("invalid country code")
+	ErrTooShort           = errors.New("phone number too short")
+	ErrTooLong            = errors.New("phone number too long")
+)
+
+var (
+	reExtension = regexp.MustCompile(extensionPattern + `\s*$`)
+
+	// Letters ending a digit run, e.g. "1-800-FLOWERS" or "1 800 555 FOOD"
+	reVanity = regexp.MustCompile(`^(\+?[\d \-.()]*\d[ \-.]?)([A-Za-z]{4,7})$`)
+
+	// Phone keypad letters, e.g. "1-800-FLOWERS" -> "1-800-3569377"
+	vanityReplacer = strings.NewReplacer(
+		"A", "2", "B", "2", "C", "2", "D", "3", "E", "3", "F", "3", "G", "4", "H", "4", "I", "4",
+		"J", "5", "K", "5", "L", "5", "M", "6", "N", "6", "O", "6", "P", "7", "Q", "7", "R", "7", "S", "7",
+		"T", "8", "U", "8", "V", "8", "W", "9", "X", "9", "Y", "9", "Z", "9",
+	)
+)
+
+// PhoneNumber is a phone number parsed against the embedded numbering metadata.
+type PhoneNumber struct {
+	Raw            string // Input as given to Parse
+	CountryCode    int    // Country calling code, e.g. 33
+	NationalNumber string // National significant number, without any national prefix, e.g. "612345678"
+	Region         string // ISO 3166-1 alpha-2 code of the region the number belongs to, e.g. "FR"
+	E164           string // E.164 representation, e.g. "+33612345678"
+	Extension      string // Digits of the extension, e.g. "42" for "... ext. 42"
+	IsPossible     bool   // Length of the national number is possible in the region
+	IsValid        bool   // National number matches the numbering plan of the region
+}
+
+// Parse parses a phone number written in any common notation, including vanity numbers and extensions.
+// Numbers without an international prefix
+// ("+" or the international dialling prefix of the region) are interpreted as national numbers of defaultRegion,
+// an ISO 3166-1 alpha-2 code which may be empty when the number is known to be international.
+// Examples:
+//
+//	Parse("06 12 34 56 78", "FR")      -> +33612345678
+//	Parse("+33 (0)6 12 34 56 78", "")  -> +33612345678
+//	Parse("0033 6 12 34 56 78", "FR")  -> +33612345678
+//	Parse("(415) 555-2671", "US")      -> +14155552671
+//	Parse("+1 800 FLOWERS ext. 42", "") -> +18003569377, extension 42
+func Parse(raw, defaultRegion string) (PhoneNumber, error) {
+	number := PhoneNumber{Raw: raw}
+
+	withoutExtension, extension := splitExtension(raw)
+	number.Extension = extension
+
+	digits, international, err := normalizeDigits(withoutExtension)
+	if err != nil {
+		return number, err
+	}
+
+	defaultRegion = strings.ToUpper(strings.TrimSpace(defaultRegion))
+	md := regionMetadataByRegion[defaultRegion]
+
+	if !international {
+		digits, international = stripInternationalPrefix(digits, md)
+	}
+
+	var nsn string
+
+	if international {
+		number.CountryCode, nsn = extractCountryCode(digits)
+		if number.CountryCode == 0 {
+			return number, ErrInvalidCountryCode
+		}
+
+		md = regionMetadataByRegion[countryCodeRegions[number.CountryCode][0]]
+	} else {
+		if md == nil {
+			return number, ErrInvalidCountryCode
+		}
+
+		number.CountryCode, nsn = md.countryCode, digits
+	}
+
+	nsn = stripNationalPrefix(nsn, md)
+
+	switch {
+	case len(nsn) < minNationalNumberLength:
+		return number, ErrTooShort
+	case len(nsn) > maxNationalNumberLength:
+		return number, ErrTooLong
+	}
+
+	number.NationalNumber = nsn
+	number.Region = regionForNumber(number.CountryCode, nsn)
+	number.E164 = "+" + strconv.Itoa(number.CountryCode) + nsn
+	number.IsPossible = isPossibleNumber(number)
+	number.IsValid = isValidNumber(number)
+
+	return number, nil
+}
+
+// splitExtension splits the extension written after a phone number, if any.
+func splitExtension(raw string) (string, string) {
+	loc := reExtension.FindStringSubmatchIndex(raw)
+	if loc == nil {
+		return raw, ""
+	}
+
+	return raw[:loc[0]], raw[loc[2]:loc[3]]
+}
+
+// convertVanityLetters converts the letters of vanity numbers to their keypad digits. Only a group of 4 to 7 letters
+// ending a run of 3 to 8 digits is converted, so that text, e.g. "+33 6 12 34 56 78 (mobile)", is left as-is.
+func convertVanityLetters(raw string) string {
+	m := reVanity.FindStringSubmatch(strings.TrimSpace(raw))
+	if m == nil {
+		return raw
+	}
+
+	if digits := countDigits(m[1]); digits < minVanityDigits || digits > maxVanityDigits {
+		return raw
+	}
+
+	return m[1] + vanityReplacer.Replace(strings.ToUpper(m[2]))
+}
+
+// normalizeDigits returns the ASCII digits of raw and whether it starts with a "+".
+func normalizeDigits(raw string) (string, bool, error) {
+	raw = strings.TrimSpace(norm.NFKC.String(raw)) // Maps full-width digits and signs to ASCII
+	raw = convertVanityLetters(raw)
+
+	var digits strings.Builder
+
+	for _, r := range raw {
+		switch {
+		case r >= '0' && r <= '9':
+			digits.WriteRune(r)
+		case r == '+', unicode.IsSpace(r), strings.ContainsRune("-.()/[]", r):
+			continue
+		default:
+			return "", false, ErrNotANumber
+		}
+	}
+
+	if digits.Len() == 0 {
+		return "", false, ErrNotANumber
+	}
+
+	return digits.String(), strings.HasPrefix(raw, "+"), nil
+}
+
+// stripInternationalPrefix removes the international dialling prefix of the region (or "00" when the region is unknown).
+func stripInternationalPrefix(digits string, md *regionMetadata) (string, bool) {
+	prefix := "00"
+	if md != nil {
+		prefix = md.internationalPrefix
+	}
+
+	if rest, found := strings.CutPrefix(digits, prefix); found {
+		return rest, true
+	}
+
+	return digits, false
+}
+
+// extractCountryCode splits the leading country calling code from digits. It returns 0 when none is known.
+func extractCountryCode(digits string) (int, string) {
+	for i := 1; i <= 3 && i < len(digits); i++ {
+		cc, err := strconv.Atoi(digits[:i])
+		if err != nil {
+			return 0, digits
+		}
+
+		if _, found := countryCodeRegions[cc]; found {
+			return cc, digits[i:]
+		}
+	}
+
+	return 0, digits
+}
+
+// stripNationalPrefix removes the national prefix of the region, e.g. "0" in France, unless the number as-is
+// already fits the numbering plan better. Also handles the "+33 (0)6..." notation.
+func stripNationalPrefix(nsn string, md *regionMetadata) string {
+	if md == nil || md.nationalPrefix == "" {
+		return nsn
+	}
+
+	stripped, found := strings.CutPrefix(nsn, md.nationalPrefix)
+	if !found {
+		return nsn
+	}
+
+	if md.pattern.MatchString(stripped) {
+		return stripped
+	}
+
+	if md.pattern.MatchString(nsn) || slices.Contains(md.possibleLengths, len(nsn)) {
+		return nsn
+	}
+
+	return stripped
+}
+
+// regionForNumber returns the region of a number among those sharing its country code, e.g. CA or US for +1.
+func regionForNumber(countryCode int, nsn string) string {
+	regions := countryCodeRegions[countryCode]
+	for _, region := range regions[1:] {
+		if md, found := regionMetadataByRegion[region]; found && md.pattern.MatchString(nsn) {
+			return region
+		}
+	}
+
+	return regions[0]
+}
+
+func isPossibleNumber(number PhoneNumber) bool {
+	if md, found := regionMetadataByRegion[number.Region]; found {
+		return slices.Contains(md.possibleLengths, len(number.NationalNumber))
+	}
+
+	return len(number.NationalNumber) >= minGenericNumberLength && len(number.E164)-1 <= maxE164Length
+}
+
+func isValidNumber(number PhoneNumber) bool {
+	md, found := regionMetadataByRegion[number.Region]
+
+	return found && md.pattern.MatchString(number.NationalNumber)
+}
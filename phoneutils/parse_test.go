@@ -0,0 +1,196 @@
+package phoneutils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		raw           string
+		defaultRegion string
+		wantE164      string
+		wantRegion    string
+		wantValid     bool
+		wantErr       error
+	}{
+		{
+			name:          "Given French national number should return E.164",
+			raw:           "06 12 34 56 78",
+			defaultRegion: "FR",
+			wantE164:      "+33612345678",
+			wantRegion:    "FR",
+			wantValid:     true,
+		},
+		{
+			name:       "Given French international number should return same E.164 as national",
+			raw:        "+33 6 12 34 56 78",
+			wantE164:   "+33612345678",
+			wantRegion: "FR",
+			wantValid:  true,
+		},
+		{
+			name:          "Given international number with bracketed national prefix should strip it",
+			raw:           "+33 (0)6 12 34 56 78",
+			defaultRegion: "DE",
+			wantE164:      "+33612345678",
+			wantRegion:    "FR",
+			wantValid:     true,
+		},
+		{
+			name:          "Given international dialling prefix of the region should read country code",
+			raw:           "0033 6 12 34 56 78",
+			defaultRegion: "fr",
+			wantE164:      "+33612345678",
+			wantRegion:    "FR",
+			wantValid:     true,
+		},
+		{
+			name:          "Given US number with trunk prefix should return E.164",
+			raw:           "1 (415) 555-2671",
+			defaultRegion: "US",
+			wantE164:      "+14155552671",
+			wantRegion:    "US",
+			wantValid:     true,
+		},
+		{
+			name:          "Given US international dialling prefix should read country code",
+			raw:           "011 44 20 7946 0000",
+			defaultRegion: "US",
+			wantE164:      "+442079460000",
+			wantRegion:    "GB",
+			wantValid:     true,
+		},
+		{
+			name:       "Given Canadian area code should return CA region",
+			raw:        "+1 514-555-0123",
+			wantE164:   "+15145550123",
+			wantRegion: "CA",
+			wantValid:  true,
+		},
+		{
+			name:          "Given Italian fixed line should keep leading zero",
+			raw:           "06 6982 1234",
+			defaultRegion: "IT",
+			wantE164:      "+390669821234",
+			wantRegion:    "IT",
+			wantValid:     true,
+		},
+		{
+			name:          "Given full-width digits should return E.164",
+			raw:           "０６１２３４５６７８",
+			defaultRegion: "FR",
+			wantE164:      "+33612345678",
+			wantRegion:    "FR",
+			wantValid:     true,
+		},
+		{
+			name:       "Given region without metadata should parse but not validate",
+			raw:        "+86 138 0013 8000",
+			wantE164:   "+8613800138000",
+			wantRegion: "CN",
+			wantValid:  false,
+		},
+		{
+			name:          "Given wrong length should parse but not validate",
+			raw:           "06 12 34 56",
+			defaultRegion: "FR",
+			wantE164:      "+336123456",
+			wantRegion:    "FR",
+			wantValid:     false,
+		},
+		{
+			name:    "Given national number without region should return error",
+			raw:     "06 12 34 56 78",
+			wantErr: ErrInvalidCountryCode,
+		},
+		{
+			name:    "Given unknown country code should return error",
+			raw:     "+999 123 456",
+			wantErr: ErrInvalidCountryCode,
+		},
+		{
+			name:          "Given text should return error",
+			raw:           "not found",
+			defaultRegion: "FR",
+			wantErr:       ErrNotANumber,
+		},
+		{
+			name:          "Given number followed by a label should return error",
+			raw:           "06 12 34 56 78 (mobile)",
+			defaultRegion: "FR",
+			wantErr:       ErrNotANumber,
+		},
+		{
+			name:          "Given empty string should return error",
+			raw:           "",
+			defaultRegion: "FR",
+			wantErr:       ErrNotANumber,
+		},
+		{
+			name:    "Given a single digit should return error",
+			raw:     "+33 1",
+			wantErr: ErrTooShort,
+		},
+		{
+			name:    "Given too many digits should return error",
+			raw:     "+33 6123456789012345678",
+			wantErr: ErrTooLong,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			number, err := Parse(tt.raw, tt.defaultRegion)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.wantE164, number.E164)
+			require.Equal(t, tt.wantRegion, number.Region)
+			require.Equal(t, tt.wantValid, number.IsValid)
+			require.Equal(t, tt.raw, number.Raw)
+		})
+	}
+}
+
+func TestParseExtensionAndVanity(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		raw           string
+		defaultRegion string
+		wantE164      string
+		wantExtension string
+	}{
+		{"Vanity number with extension", "+1 800 FLOWERS ext. 42", "", "+18003569377", "42"},
+		{"Lowercase vanity number", "1-800-flowers", "US", "+18003569377", ""},
+		{"Extension with x", "+44 20 7946 0000 x123", "", "+442079460000", "123"},
+		{"Extension with x without space", "(415) 555-2671x7", "US", "+14155552671", "7"},
+		{"Extension with hash", "01 42 68 53 00 #12", "FR", "+33142685300", "12"},
+		{"Extension with poste", "01 42 68 53 00 poste 12", "FR", "+33142685300", "12"},
+		{"Extension with Durchwahl", "+49 30 1234567 Durchwahl: 89", "", "+49301234567", "89"},
+		{"Extension in RFC 3966", "+33142685300;ext=12", "", "+33142685300", "12"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			number, err := Parse(tt.raw, tt.defaultRegion)
+			require.NoError(t, err)
+			require.Equal(t, tt.wantE164, number.E164)
+			require.Equal(t, tt.wantExtension, number.Extension)
+		})
+	}
+}
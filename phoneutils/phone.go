@@ -5,6 +5,8 @@ import (
 	"strings"
 )
 
+// FormatPhonesAndProviders zips providers and phones by index into "provider: phone, ..." strings.
+// Prefer PhoneResults, which keeps each provider attached to its phone.
 func FormatPhonesAndProviders(providers, phones []string) string {
 	var result []string
 
@@ -22,12 +24,18 @@ func FormatPhonesAndProviders(providers, phones []string) string {
 // Clean formats a phone number string by:
 // - Preserving a leading + if present
 // - Removing all other + symbols
+// - Removing the extension, if any (see Parse to keep it)
+// - Converting the letters of vanity numbers to digits
 // - Removing all non-digit characters (spaces, dashes, parentheses, etc)
 // Examples:
 //
-//	"+1 (555) 123-4567" -> "+15551234567"
-//	"1+555-123-4567"    -> "15551234567"
+//	"+1 (555) 123-4567"      -> "+15551234567"
+//	"1+555-123-4567"         -> "15551234567"
+//	"+1 800 FLOWERS ext. 42" -> "+18003569377"
 func Clean(phone string) string {
+	phone, _ = splitExtension(phone)
+	phone = convertVanityLetters(phone)
+
 	cleaned := regexp.MustCompile(`[^\d]`).ReplaceAllString(phone, "")
 	if cleaned == "" {
 		return ""
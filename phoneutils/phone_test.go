@@ -123,6 +123,31 @@ func TestClean(t *testing.T) {
 			input:    "not found",
 			expected: "",
 		},
+		{
+			name:     "extension is removed",
+			input:    "+1 (555) 123-4567 ext. 42",
+			expected: "+15551234567",
+		},
+		{
+			name:     "vanity letters are converted",
+			input:    "+1 800 FLOWERS ext. 42",
+			expected: "+18003569377",
+		},
+		{
+			name:     "vanity letters after digit groups are converted",
+			input:    "1 800 555 food",
+			expected: "18005553663",
+		},
+		{
+			name:     "label in parentheses is removed",
+			input:    "+33 6 12 34 56 78 (mobile)",
+			expected: "+33612345678",
+		},
+		{
+			name:     "label after number is removed",
+			input:    "+1 555-123-4567 cell",
+			expected: "+15551234567",
+		},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,166 @@
+package phoneutils
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+)
+
+// resultEscaper escapes the separators of the legacy format in providers and numbers, e.g. the comma of
+// "+1 555 1234, ext 5", see ParsePhoneResults.
+var resultEscaper = strings.NewReplacer(`\`, `\\`, ",", `\,`)
+
+// PhoneResult is a phone number returned by an enrichment provider.
+type PhoneResult struct {
+	Provider   string
+	Number     string
+	Type       PhoneType
+	Confidence float64 // Between 0 and 1
+}
+
+// PhoneResults is a ranked collection of phone numbers returned by several providers.
+type PhoneResults []PhoneResult
+
+// typeRanks orders phone types from the most to the least useful to reach a contact.
+var typeRanks = map[PhoneType]int{
+	PhoneTypeMobile:            0,
+	PhoneTypeFixedLineOrMobile: 1,
+	PhoneTypeFixedLine:         2,
+	PhoneTypeVoIP:              3,
+	PhoneTypeTollFree:          4,
+	PhoneTypePager:             5,
+	PhoneTypePremium:           6,
+}
+
+// MergePhoneResults merges the results designating the same line (see Dedupe) and ranks them by confidence, then type.
+// Phones without an international prefix are parsed as national numbers of defaultRegion, which may be empty.
+// Results without a known type are classified with NumberType. Merged results keep the provider with the highest
+// confidence and the best-formatted number, and their confidence is raised by each corroborating provider.
+func MergePhoneResults(results []PhoneResult, defaultRegion string) PhoneResults {
+	merged := make(PhoneResults, 0, len(results))
+	numbers := make([][]string, 0, len(results)) // Numbers of the results merged into each of merged
+
+	for _, r := range results {
+		if strings.TrimSpace(r.Number) == "" {
+			continue
+		}
+
+		if r.Type == "" || r.Type == PhoneTypeUnknown {
+			r.Type = NumberType(r.Number, defaultRegion)
+		}
+
+		i := slices.IndexFunc(numbers, func(group []string) bool { return matchesAll(group, r.Number, defaultRegion) })
+		if i < 0 {
+			merged = append(merged, r)
+			numbers = append(numbers, []string{r.Number})
+
+			continue
+		}
+
+		merged[i] = mergePhoneResult(merged[i], r, defaultRegion)
+		numbers[i] = append(numbers[i], r.Number)
+	}
+
+	slices.SortStableFunc(merged, func(a, b PhoneResult) int {
+		if c := cmp.Compare(b.Confidence, a.Confidence); c != 0 {
+			return c
+		}
+
+		return cmp.Compare(typeRank(a.Type), typeRank(b.Type))
+	})
+
+	return merged
+}
+
+// ParsePhoneResults parses phones formatted by PhoneResults.String or FormatPhonesAndProviders,
+// e.g. "Provider1: 1234567890, 0987654321". Commas escaped with a backslash by String, e.g. in "+1 555 1234\, ext 5",
+// don't separate results. Types are left unknown and confidences zero.
+func ParsePhoneResults(s string) PhoneResults {
+	var results PhoneResults
+
+	for _, part := range splitUnescaped(s) {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		var result PhoneResult
+
+		if provider, number, found := strings.Cut(part, ": "); found {
+			result.Provider, result.Number = strings.TrimSpace(provider), strings.TrimSpace(number)
+		} else {
+			result.Number = part
+		}
+
+		results = append(results, result)
+	}
+
+	return results
+}
+
+// String formats the results in the legacy "provider: phone, ..." format of FormatPhonesAndProviders. Commas and
+// backslashes of providers and numbers are escaped with a backslash, so that ParsePhoneResults reads them back.
+func (r PhoneResults) String() string {
+	parts := make([]string, 0, len(r))
+
+	for _, result := range r {
+		if result.Provider == "" {
+			parts = append(parts, resultEscaper.Replace(result.Number))
+		} else {
+			parts = append(parts, resultEscaper.Replace(result.Provider)+": "+resultEscaper.Replace(result.Number))
+		}
+	}
+
+	return strings.Join(parts, ", ")
+}
+
+// splitUnescaped splits s around the commas not escaped by String, and unescapes the parts.
+func splitUnescaped(s string) []string {
+	var (
+		parts []string
+		part  strings.Builder
+	)
+
+	for i := 0; i < len(s); i++ {
+		switch {
+		case s[i] == '\\' && i+1 < len(s):
+			i++
+			part.WriteByte(s[i])
+		case s[i] == ',':
+			parts = append(parts, part.String())
+			part.Reset()
+		default:
+			part.WriteByte(s[i])
+		}
+	}
+
+	return append(parts, part.String())
+}
+
+func mergePhoneResult(a, b PhoneResult, defaultRegion string) PhoneResult {
+	merged := a
+
+	if b.Confidence > a.Confidence {
+		merged.Provider = b.Provider
+	}
+
+	if formattingScore(b.Number, defaultRegion) > formattingScore(a.Number, defaultRegion) {
+		merged.Number = b.Number
+	}
+
+	if a.Type == PhoneTypeUnknown {
+		merged.Type = b.Type
+	}
+
+	merged.Confidence = 1 - (1-a.Confidence)*(1-b.Confidence)
+
+	return merged
+}
+
+func typeRank(phoneType PhoneType) int {
+	if rank, found := typeRanks[phoneType]; found {
+		return rank
+	}
+
+	return len(typeRanks)
+}
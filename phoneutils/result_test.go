@@ -0,0 +1,124 @@
+package phoneutils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMergePhoneResults(t *testing.T) {
+	t.Parallel()
+
+	results := []PhoneResult{
+		{Provider: "ProviderA", Number: "01 42 68 53 00", Confidence: 0.9},
+		{Provider: "ProviderB", Number: "+33612345678", Confidence: 0.5},
+		{Provider: "ProviderC", Number: "06 12 34 56 78", Confidence: 0.6},
+		{Provider: "ProviderD", Number: "0800 123 456", Confidence: 0.9},
+		{Provider: "ProviderE", Number: "", Confidence: 1},
+	}
+
+	actual := MergePhoneResults(results, "FR")
+	require.Equal(t, PhoneResults{
+		{Provider: "ProviderA", Number: "01 42 68 53 00", Type: PhoneTypeFixedLine, Confidence: 0.9},
+		{Provider: "ProviderD", Number: "0800 123 456", Type: PhoneTypeTollFree, Confidence: 0.9},
+		{Provider: "ProviderC", Number: "+33612345678", Type: PhoneTypeMobile, Confidence: 0.8},
+	}, actual)
+}
+
+func TestMergePhoneResultsKeepsExtensions(t *testing.T) {
+	t.Parallel()
+
+	actual := MergePhoneResults([]PhoneResult{
+		{Provider: "ProviderA", Number: "+44 20 7946 0000", Confidence: 0.5},
+		{Provider: "ProviderB", Number: "+44 20 7946 0000 ext 1", Confidence: 0.5},
+		{Provider: "ProviderC", Number: "+44 20 7946 0000 ext 2", Confidence: 0.5},
+	}, "GB")
+
+	require.Len(t, actual, 2)
+	require.Equal(t, "+44 20 7946 0000 ext 1", actual[0].Number)
+	require.Equal(t, "+44 20 7946 0000 ext 2", actual[1].Number)
+}
+
+func TestMergePhoneResultsKeepsProviderType(t *testing.T) {
+	t.Parallel()
+
+	actual := MergePhoneResults([]PhoneResult{{Provider: "ProviderA", Number: "+8613800138000", Type: PhoneTypeMobile}}, "")
+	require.Equal(t, PhoneTypeMobile, actual[0].Type)
+}
+
+func TestPhoneResultsString(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		results  PhoneResults
+		expected string
+	}{
+		{
+			name:     "Given results with providers should return legacy format",
+			results:  PhoneResults{{Provider: "Provider1", Number: "1234567890"}, {Provider: "Provider2", Number: "0987654321"}},
+			expected: "Provider1: 1234567890, Provider2: 0987654321",
+		},
+		{
+			name:     "Given result without provider should return phone only",
+			results:  PhoneResults{{Provider: "Provider1", Number: "1234567890"}, {Number: "0987654321"}},
+			expected: "Provider1: 1234567890, 0987654321",
+		},
+		{
+			name:     "Given no results should return empty string",
+			expected: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tt.expected, tt.results.String())
+		})
+	}
+}
+
+func TestParsePhoneResults(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		s        string
+		expected PhoneResults
+	}{
+		{
+			name:     "Given legacy format should return results",
+			s:        "Provider1: +33 6 12 34 56 78, 0987654321",
+			expected: PhoneResults{{Provider: "Provider1", Number: "+33 6 12 34 56 78"}, {Number: "0987654321"}},
+		},
+		{
+			name:     "Given FormatPhonesAndProviders output should round trip",
+			s:        FormatPhonesAndProviders([]string{"Provider1", "Provider2"}, []string{"1234567890", "0987654321"}),
+			expected: PhoneResults{{Provider: "Provider1", Number: "1234567890"}, {Provider: "Provider2", Number: "0987654321"}},
+		},
+		{
+			name: "Given extension after a comma should round trip",
+			s: PhoneResults{
+				{Provider: "Provider1", Number: "+1 555 1234, ext 5"},
+				{Number: "0987654321"},
+			}.String(),
+			expected: PhoneResults{{Provider: "Provider1", Number: "+1 555 1234, ext 5"}, {Number: "0987654321"}},
+		},
+		{
+			name:     "Given empty string should return no results",
+			s:        "",
+			expected: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			actual := ParsePhoneResults(tt.s)
+			require.Equal(t, tt.expected, actual)
+			require.Equal(t, tt.s, actual.String())
+		})
+	}
+}
@@ -0,0 +1,144 @@
+package utils
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"time"
+)
+
+const (
+	defaultRedirectTimeout = 10 * time.Second
+	defaultRedirectMaxHops = 10
+	maxRedirectBodyDrain   = 4 << 10 // Bytes of GET bodies read to reuse connections
+)
+
+var (
+	ErrTooManyRedirects = errors.New("too many redirects")
+	ErrRedirectLoop     = errors.New("redirect loop")
+)
+
+// DefaultRedirectResolver is the resolver used by DomainFromURLBypassingShortener and GetRedirectedDomainFromDomain,
+// and by their Context variants when given a nil resolver.
+var DefaultRedirectResolver = &RedirectResolver{}
+
+// RedirectResolver follows the HTTP redirects of URLs, e.g. of URL shorteners. Each hop is requested with HEAD, and
+// with GET when the server rejects HEAD. The zero value is ready to use.
+type RedirectResolver struct {
+	Client  *http.Client  // Sends requests, its CheckRedirect is ignored, http.DefaultClient when nil
+	Timeout time.Duration // Maximum duration of a resolution, all hops included, 10s when zero
+	MaxHops int           // Maximum number of redirects followed, 10 when zero
+}
+
+// RedirectResult is the result of RedirectResolver.Resolve.
+type RedirectResult struct {
+	URL        string   // Final URL, e.g. "https://www.surfe.com/"
+	Chain      []string // URLs requested, from the given URL to the final one
+	StatusCode int      // Status code of the response to the final URL
+}
+
+// Redirected reports whether the given URL redirected to another one.
+func (r RedirectResult) Redirected() bool {
+	return len(r.Chain) > 1
+}
+
+// Resolve follows the redirects of rawURL until a response which isn't a redirect, e.g. "https://bit.ly/x" ->
+// "https://t.co/y" -> "https://www.surfe.com/". It returns the chain followed so far along with an error when a
+// request failed, ctx is done or the timeout expired, a URL was visited twice, or MaxHops was exceeded: URL is then
+// the last URL reached, e.g. the Location of a redirect to an unreachable host. Responses with an error status are
+// final, not errors.
+func (r *RedirectResolver) Resolve(ctx context.Context, rawURL string) (RedirectResult, error) {
+	ctx, cancel := context.WithTimeout(ctx, cmp.Or(r.Timeout, defaultRedirectTimeout))
+	defer cancel()
+
+	// Redirects are followed here, to record them
+	client := *cmp.Or(r.Client, http.DefaultClient)
+	client.CheckRedirect = func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse }
+
+	result := RedirectResult{URL: rawURL}
+
+	for hops := 0; ; hops++ {
+		result.Chain = append(result.Chain, result.URL)
+
+		resp, err := requestRedirect(ctx, &client, result.URL)
+		if err != nil {
+			return result, err
+		}
+
+		result.StatusCode = resp.StatusCode
+		location := resp.Header.Get("Location")
+
+		if !isRedirectStatus(resp.StatusCode) || location == "" {
+			return result, nil
+		}
+
+		next, err := resp.Request.URL.Parse(location)
+		if err != nil {
+			return result, fmt.Errorf("parse redirect location %q of %s: %w", location, result.URL, err)
+		}
+
+		if hops == cmp.Or(r.MaxHops, defaultRedirectMaxHops) {
+			return result, fmt.Errorf("follow %s: %w", result.URL, ErrTooManyRedirects)
+		}
+
+		if slices.Contains(result.Chain, next.String()) {
+			return result, fmt.Errorf("follow %s to %s: %w", result.URL, next, ErrRedirectLoop)
+		}
+
+		result.URL = next.String()
+	}
+}
+
+// requestRedirect requests rawURL with HEAD, falling back to GET when HEAD fails or is answered with an error status.
+func requestRedirect(ctx context.Context, client *http.Client, rawURL string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, rawURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("request %s: %w", rawURL, err)
+	}
+
+	resp, err := doRedirectRequest(client, req)
+	if err == nil && resp.StatusCode < http.StatusBadRequest {
+		return resp, nil
+	}
+
+	if ctx.Err() != nil {
+		return nil, fmt.Errorf("request %s: %w", rawURL, cmp.Or(err, ctx.Err()))
+	}
+
+	req = req.Clone(ctx)
+	req.Method = http.MethodGet
+
+	resp, err = doRedirectRequest(client, req)
+	if err != nil {
+		return nil, fmt.Errorf("request %s: %w", rawURL, err)
+	}
+
+	return resp, nil
+}
+
+// doRedirectRequest sends req and closes the body of the response, only its status and headers being needed.
+func doRedirectRequest(client *http.Client, req *http.Request) (*http.Response, error) {
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	_, _ = io.CopyN(io.Discard, resp.Body, maxRedirectBodyDrain)
+	_ = resp.Body.Close()
+
+	return resp, nil
+}
+
+func isRedirectStatus(statusCode int) bool {
+	switch statusCode {
+	case http.StatusMovedPermanently, http.StatusFound, http.StatusSeeOther, http.StatusTemporaryRedirect,
+		http.StatusPermanentRedirect:
+		return true
+	default:
+		return false
+	}
+}
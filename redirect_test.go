@@ -0,0 +1,176 @@
+package utils
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRedirectResolverResolve(t *testing.T) {
+	t.Parallel()
+
+	site := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/slow":
+			time.Sleep(200 * time.Millisecond)
+		case "/gone":
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(site.Close)
+
+	shortener := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/chain":
+			http.Redirect(w, r, "/hop", http.StatusMovedPermanently)
+		case "/hop":
+			http.Redirect(w, r, site.URL+"/landing", http.StatusFound)
+		case "/get-only":
+			if r.Method == http.MethodHead {
+				w.WriteHeader(http.StatusMethodNotAllowed)
+
+				return
+			}
+
+			http.Redirect(w, r, site.URL+"/", http.StatusTemporaryRedirect)
+		case "/gone":
+			http.Redirect(w, r, site.URL+"/gone", http.StatusFound)
+		case "/loop-a":
+			http.Redirect(w, r, "/loop-b", http.StatusFound)
+		case "/loop-b":
+			http.Redirect(w, r, "/loop-a", http.StatusFound)
+		case "/slow":
+			http.Redirect(w, r, site.URL+"/slow", http.StatusFound)
+		}
+	}))
+	t.Cleanup(shortener.Close)
+
+	tests := []struct {
+		name        string
+		resolver    *RedirectResolver
+		url         string
+		expected    RedirectResult
+		expectedErr error
+	}{
+		{
+			name:     "Given chain of redirects should follow all hops",
+			resolver: &RedirectResolver{},
+			url:      shortener.URL + "/chain",
+			expected: RedirectResult{
+				URL:        site.URL + "/landing",
+				Chain:      []string{shortener.URL + "/chain", shortener.URL + "/hop", site.URL + "/landing"},
+				StatusCode: http.StatusOK,
+			},
+		},
+		{
+			name:     "Given server rejecting HEAD should fall back to GET",
+			resolver: &RedirectResolver{},
+			url:      shortener.URL + "/get-only",
+			expected: RedirectResult{
+				URL:        site.URL + "/",
+				Chain:      []string{shortener.URL + "/get-only", site.URL + "/"},
+				StatusCode: http.StatusOK,
+			},
+		},
+		{
+			name:     "Given URL without redirect should return it",
+			resolver: &RedirectResolver{},
+			url:      site.URL + "/landing",
+			expected: RedirectResult{
+				URL:        site.URL + "/landing",
+				Chain:      []string{site.URL + "/landing"},
+				StatusCode: http.StatusOK,
+			},
+		},
+		{
+			name:     "Given redirect to error page should return its status",
+			resolver: &RedirectResolver{},
+			url:      shortener.URL + "/gone",
+			expected: RedirectResult{
+				URL:        site.URL + "/gone",
+				Chain:      []string{shortener.URL + "/gone", site.URL + "/gone"},
+				StatusCode: http.StatusNotFound,
+			},
+		},
+		{
+			name:     "Given redirect loop should return error",
+			resolver: &RedirectResolver{},
+			url:      shortener.URL + "/loop-a",
+			expected: RedirectResult{
+				URL:        shortener.URL + "/loop-b",
+				Chain:      []string{shortener.URL + "/loop-a", shortener.URL + "/loop-b"},
+				StatusCode: http.StatusFound,
+			},
+			expectedErr: ErrRedirectLoop,
+		},
+		{
+			name:     "Given more hops than maximum should return error",
+			resolver: &RedirectResolver{MaxHops: 1},
+			url:      shortener.URL + "/chain",
+			expected: RedirectResult{
+				URL:        shortener.URL + "/hop",
+				Chain:      []string{shortener.URL + "/chain", shortener.URL + "/hop"},
+				StatusCode: http.StatusFound,
+			},
+			expectedErr: ErrTooManyRedirects,
+		},
+		{
+			name:     "Given slow server should time out",
+			resolver: &RedirectResolver{Timeout: 50 * time.Millisecond},
+			url:      shortener.URL + "/slow",
+			expected: RedirectResult{
+				URL:        site.URL + "/slow",
+				Chain:      []string{shortener.URL + "/slow", site.URL + "/slow"},
+				StatusCode: http.StatusFound,
+			},
+			expectedErr: context.DeadlineExceeded,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			result, err := tt.resolver.Resolve(context.Background(), tt.url)
+			require.ErrorIs(t, err, tt.expectedErr)
+			require.Equal(t, tt.expected, result)
+			require.Equal(t, len(tt.expected.Chain) > 1, result.Redirected())
+		})
+	}
+}
+
+func TestRedirectResolverClient(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/short" {
+			http.Redirect(w, r, "/long", http.StatusFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	var requests atomic.Int32
+
+	client := &http.Client{Transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		requests.Add(1)
+
+		return http.DefaultTransport.RoundTrip(req)
+	})}
+
+	result, err := (&RedirectResolver{Client: client}).Resolve(context.Background(), server.URL+"/short")
+	require.NoError(t, err)
+	require.Equal(t, server.URL+"/long", result.URL)
+	require.Equal(t, int32(2), requests.Load())
+	require.Nil(t, client.CheckRedirect)
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
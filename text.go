@@ -2,6 +2,7 @@ package utils
 
 import (
 	"bytes"
+	"cmp"
 	"context"
 	"crypto/sha256"
 	"encoding/gob"
@@ -9,7 +10,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
-	"net/http"
+	"net"
 	"net/url"
 	"regexp"
 	"strings"
@@ -20,10 +21,10 @@ import (
 	"github.com/JohannesKaufmann/html-to-markdown/plugin"
 	"github.com/PuerkitoBio/goquery"
 	"github.com/gosimple/unidecode"
-	"github.com/jpillora/go-tld"
 	"github.com/kennygrant/sanitize"
 	"github.com/pariz/gountries"
 	"github.com/surfe/logger/v2"
+	"github.com/surfe/utils/domainlist"
 	"github.com/surfe/utils/urls"
 	"github.com/yuin/goldmark"
 	"github.com/yuin/goldmark/extension"
@@ -42,6 +43,9 @@ var (
 	ErrNoRedirects = errors.New("no redirects found")
 )
 
+// errShortenerRedirect wraps the errors of following the redirects of URL shorteners, unlike those of parsing URLs.
+var errShortenerRedirect = errors.New("follow URL shortener")
+
 func MaskEmail(str string) string {
 	parts := strings.Split(str, "@")
 	if len(parts) != 2 || len(parts[0]) < 3 || len(parts[1]) < 3 {
@@ -67,10 +71,25 @@ func MaskStringWithAsterisks(str string) string {
 	return str[0:1] + strings.Repeat("*", len(str)-2) + str[len(str)-1:]
 }
 
-func ToValidEmail(email string) (string, bool) {
+// ToValidEmail lowercases email and validates it with ValidateEmail.
+// Valid emails are returned with their domain in the optional form, that of email by default, invalid ones as
+// lowercased.
+func ToValidEmail(email string, form ...DomainForm) (string, bool) {
 	email = strings.TrimSpace(strings.ToLower(email))
 
-	return email, reEmail.MatchString(email)
+	verdict := ValidateEmail(email)
+	if !verdict.Valid {
+		return email, false
+	}
+
+	if domainFormOf(email, form) == DomainFormUnicode {
+		at := strings.LastIndexByte(verdict.Email, '@')
+		if domain, err := ConvertDomain(verdict.Email[at+1:], DomainFormUnicode); err == nil {
+			return verdict.Email[:at+1] + domain, true
+		}
+	}
+
+	return verdict.Email, true
 }
 
 // Title capitalizes the first letter of a string.
@@ -96,134 +115,138 @@ func Title(s string) string {
 	return strings.TrimSpace(string(runes))
 }
 
-func DomainFromURLBypassingShortener(s string) string {
-	if strings.TrimSpace(s) == "" {
-		return ""
+// DomainFromURLBypassingShortener returns the domain of the company owning s, following the redirects of URL
+// shorteners, in the optional form. Brand and country aliases are replaced by their canonical domain, e.g.
+// "google.com" for "goo.gle", see CompanyDomainAliases, but not acquisitions and rebrands, which MatchDomains reports.
+// It returns "" for public domains, e.g. social media.
+func DomainFromURLBypassingShortener(s string, form ...DomainForm) string {
+	domain, err := DomainFromURLBypassingShortenerContext(context.Background(), nil, s, form...)
+
+	switch {
+	case err == nil, errors.Is(err, ErrEmptyURL):
+	case errors.Is(err, errShortenerRedirect):
+		logger.Log(context.Background()).Err(err).Errorf("GetRedirectedDomain: %s", s)
+	default:
+		logger.Log(context.Background()).Err(err).Infof("DomainFromURLNoFiltering failed for %s", s)
 	}
 
-	domain, err := DomainFromURLNoFiltering(s)
-	if err != nil {
-		if !errors.Is(err, ErrEmptyURL) {
-			logger.Log(context.Background()).Err(err).Infof("DomainFromURLNoFiltering failed for %s", s)
-		}
+	return domain
+}
 
-		return ""
+// DomainFromURLBypassingShortenerContext is DomainFromURLBypassingShortener following redirects with resolver,
+// DefaultRedirectResolver when nil, until ctx is done. It returns errors instead of logging them: when the redirects of
+// a URL shortener can't be followed, the domain of the shortener is returned along with the error.
+func DomainFromURLBypassingShortenerContext(ctx context.Context, resolver *RedirectResolver, s string,
+	form ...DomainForm,
+) (string, error) {
+	domain, err := companyDomain(ctx, cmp.Or(resolver, DefaultRedirectResolver), s)
+
+	return toDomainForm(domain, domainFormOf(s, form)), err
+}
+
+// companyDomain implements DomainFromURLBypassingShortener, following redirects with resolver. When the redirects of
+// a URL shortener can't be followed, it returns the domain of the shortener along with the error.
+func companyDomain(ctx context.Context, resolver *RedirectResolver, s string) (string, error) {
+	domain, err := DomainFromURLNoFiltering(s, DomainFormASCII)
+	if err != nil {
+		return "", err
 	}
 
 	if urls.IsURLShortenerDomain(domain) {
-		redirectedDomain, err := getRedirectedDomain(s)
-		if err == nil {
-			domain = redirectedDomain
-		} else {
-			logger.Log(context.Background()).Err(err).Errorf("GetRedirectedDomain: %s", s)
+		rawURL := strings.TrimSpace(s)
+		if !reWebSchema.MatchString(strings.ToLower(rawURL)) {
+			rawURL = "https://" + rawURL
 		}
+
+		redirectedDomain, err := redirectedDomain(ctx, resolver, rawURL)
+		if err != nil {
+			return filterCompanyDomain(domain), fmt.Errorf("%w: %w", errShortenerRedirect, err)
+		}
+
+		domain = redirectedDomain
 	}
 
+	return filterCompanyDomain(domain), nil
+}
+
+// filterCompanyDomain returns domain, or its canonical domain when it's a brand or country alias in
+// CompanyDomainAliases, or "" when domain is a public one.
+func filterCompanyDomain(domain string) string {
 	if urls.IsPublicDomain(domain) {
 		return ""
 	}
 
-	if knownDomain, exists := knownDomains[domain]; exists {
-		return knownDomain
+	if alias, found := CompanyDomainAliases.Lookup(domain); found &&
+		(alias.Kind == domainlist.AliasBrand || alias.Kind == domainlist.AliasCountry) {
+		return alias.Canonical
 	}
 
 	return domain
 }
 
-func SubdomainWithDomainFromURL(s string) (string, error) {
-	s = strings.ToLower(s)
-	if !reWebSchema.MatchString(s) {
-		s = "//" + s // URL needs to prefixed with `//` to be parseable
-	}
-
-	u, err := tld.Parse(s)
+// SubdomainWithDomainFromURL returns the host of s without its "www." prefix, in the optional form, see ParseWebAddress.
+func SubdomainWithDomainFromURL(s string, form ...DomainForm) (string, error) {
+	address, err := ParseWebAddress(s, form...)
 	if err != nil {
 		return "", fmt.Errorf("parse domain from URL: %w", err)
 	}
 
-	if u.Domain == "" && u.TLD == "" {
-		return "", errors.New("empty domain and TLD")
-	}
-
-	if (u.Subdomain == "") || (strings.ToLower(u.Subdomain) == "www") {
-		return fmt.Sprintf("%s.%s", u.Domain, u.TLD), nil
-	}
-
-	return fmt.Sprintf("%s.%s.%s", u.Subdomain, u.Domain, u.TLD), nil
+	return address.HostWithoutWWW(), nil
 }
 
-func DomainFromURLNoFiltering(s string) (string, error) {
-	if s == "" {
+// DomainFromURLNoFiltering returns the registrable domain of s, in the optional form, see ParseWebAddress.
+func DomainFromURLNoFiltering(s string, form ...DomainForm) (string, error) {
+	address, err := ParseWebAddress(s, form...)
+	if errors.Is(err, ErrEmptyURL) {
 		return "", ErrEmptyURL
-	}
-
-	s = strings.ToLower(s)
-	if !reWebSchema.MatchString(s) {
-		s = "//" + s // URL needs to be prefixed with `//` to be parseable
-	}
-
-	u, err := tld.Parse(s)
-	if err != nil {
-		if strings.Contains(err.Error(), "cannot derive eTLD+1") {
-			tempURL, urlErr := url.Parse(s)
-			if urlErr == nil && tempURL.Host != "" && strings.Contains(tempURL.Host, ".") {
-				return tempURL.Host, nil
-			}
-		}
-
+	} else if err != nil {
 		return "", fmt.Errorf("parse domain from URL: %w", err)
 	}
 
-	if u.Domain == "" && u.TLD == "" {
-		return "", errors.New("empty domain and TLD")
-	}
-
-	return u.Domain + "." + u.TLD, nil
+	return address.Domain, nil
 }
 
-func DomainFromURL(s string) string {
-	domain, _ := DomainFromURLNoFiltering(s)
+func DomainFromURL(s string, form ...DomainForm) string {
+	domain, _ := DomainFromURLNoFiltering(s, form...)
 
 	return domain
 }
 
-func DomainFromEmail(email string) string {
+// DomainFromEmail returns the domain of email as is, or converted to the form when one is given, e.g.
+// "xn--mller-kva.de" for DomainFromEmail("jane@müller.de", DomainFormASCII).
+func DomainFromEmail(email string, form ...DomainForm) string {
 	parts := strings.Split(email, "@")
 	if len(parts) != 2 {
 		return ""
 	}
 
-	return parts[1]
+	if len(form) == 0 {
+		return parts[1]
+	}
+
+	return toDomainForm(parts[1], form[0])
 }
 
-func FormatDomainURL(domainURL string) string {
-	parsedURL, err := url.Parse(domainURL)
-	if err != nil || parsedURL == nil || parsedURL.Hostname() == "" {
+// FormatDomainURL returns the host of domainURL in the optional form, e.g. "www.surfe.com" for
+// "https://www.surfe.com/", or domainURL itself when it has no host.
+func FormatDomainURL(domainURL string, form ...DomainForm) string {
+	address, _ := ParseWebAddress(domainURL, form...)
+	if address.Host == "" {
 		return domainURL
 	}
 
-	return parsedURL.Hostname()
+	return address.Host
 }
 
 // DomainNameWithoutTLD extracts the name of the domain, e.g. input: `https://www.surfe.com/some-path`, output: `surfe`.
-func DomainNameWithoutTLD(rawURL string) string {
-	if !strings.HasPrefix(rawURL, "http") {
-		rawURL = "http://" + rawURL
-	}
-
-	parsedURL, err := url.Parse(rawURL)
+// It returns "" when rawURL has no domain. Internationalized names are returned in the optional form.
+func DomainNameWithoutTLD(rawURL string, form ...DomainForm) string {
+	address, err := ParseWebAddress(rawURL, form...)
 	if err != nil {
 		return ""
 	}
 
-	host := parsedURL.Hostname()
-	parts := strings.Split(host, ".")
-
-	if len(parts) > 1 {
-		return parts[0]
-	}
-
-	return host
+	return address.Name()
 }
 
 func RemoveQueryParams(s string) string {
@@ -238,16 +261,18 @@ func RemoveQueryParams(s string) string {
 	return u.String()
 }
 
+// SameDomains reports whether crmDomain, a domain or URL, has the domain linkedinDomain or one of its aliases,
+// see MatchDomains. Acquired companies keep their own identity: their domains don't match those of their acquirer,
+// e.g. "tableau.com" and "salesforce.com", for which MatchDomains returns DomainMatchAcquisition.
 func SameDomains(linkedinDomain, crmDomain string) bool {
-	if linkedinDomain == "" || crmDomain == "" {
+	switch MatchDomains(linkedinDomain, crmDomain) {
+	case DomainMatchNone:
 		return false
+	case DomainMatchSame:
+		return true
+	default:
+		return !isAcquisitionAlias(linkedinDomain) && !isAcquisitionAlias(crmDomain)
 	}
-
-	if v := DomainFromURL(crmDomain); v != "" {
-		return strings.EqualFold(v, linkedinDomain)
-	}
-
-	return strings.EqualFold(crmDomain, linkedinDomain)
 }
 
 func RemoveAccents(s string) (string, error) {
@@ -343,22 +368,12 @@ func TrimSuffix(s, suffix string) (string, bool) {
 	return s, false
 }
 
-// URLHostnameExtractor extracts hostname from given URL string, and removes `www.` prefix if exists.
-func URLHostnameExtractor(s string) string {
-	if s == "" {
-		return ""
-	}
-
-	if !reWebSchema.MatchString(s) {
-		s = "//" + s // URL needs to prefixed with `//` to be parseable
-	}
+// URLHostnameExtractor returns the host of s without its "www." prefix, in the optional form, e.g. "surfe.com" for
+// "https://www.surfe.com/".
+func URLHostnameExtractor(s string, form ...DomainForm) string {
+	address, _ := ParseWebAddress(s, form...)
 
-	u, err := url.Parse(s)
-	if err != nil {
-		return ""
-	}
-
-	return reWWW.ReplaceAllString(u.Hostname(), "")
+	return address.HostWithoutWWW()
 }
 
 func URLProfileExtract(s string) string {
@@ -631,17 +646,21 @@ func LinkedinURLCleanerErr(rawUrl string, escapeHandle bool) (string, error) {
 // ExtractHostAndPath takes a string containing a URL, and returns another string with the same URL without
 // the scheme (http/https), the www. subdomain and any trailing /
 // If the provided string cannot be parsed as URL, it gets returned without any trailing /.
-func ExtractHostAndPath(fullURL string) string {
+// Internationalized hosts are returned in the optional form.
+func ExtractHostAndPath(fullURL string, form ...DomainForm) string {
 	urlWithoutTrailingSlash, _ := strings.CutSuffix(fullURL, "/")
 
-	parsedURL, err := url.Parse(urlWithoutTrailingSlash)
-	if err != nil {
+	address, _ := ParseWebAddress(urlWithoutTrailingSlash, form...)
+	if address.Host == "" {
 		return urlWithoutTrailingSlash
 	}
 
-	host, _ := strings.CutPrefix(parsedURL.Host, "www.")
+	host := address.HostWithoutWWW()
+	if address.Port != "" {
+		host = net.JoinHostPort(host, address.Port)
+	}
 
-	return host + parsedURL.Path
+	return host + address.Path
 }
 
 // GenerateURLCombinations takes a string containing a URL, and returns an array with all different formats
@@ -834,7 +853,13 @@ func GetErrorString(err error) string {
 }
 
 func GetRedirectedDomainFromDomain(domain string) (string, error) {
-	domain, err := DomainFromURLNoFiltering(domain)
+	return GetRedirectedDomainFromDomainContext(context.Background(), nil, domain)
+}
+
+// GetRedirectedDomainFromDomainContext returns the domain to which the domain of rawURL, requested over HTTPS,
+// redirects, following redirects with resolver, DefaultRedirectResolver when nil, until ctx is done.
+func GetRedirectedDomainFromDomainContext(ctx context.Context, resolver *RedirectResolver, rawURL string) (string, error) {
+	domain, err := DomainFromURLNoFiltering(rawURL)
 	if err != nil {
 		return "", fmt.Errorf("domain form url no filtering: %w", err)
 	}
@@ -843,7 +868,7 @@ func GetRedirectedDomainFromDomain(domain string) (string, error) {
 		domain = "https://" + domain
 	}
 
-	redirectedDomain, err := getRedirectedDomain(domain)
+	redirectedDomain, err := redirectedDomain(ctx, cmp.Or(resolver, DefaultRedirectResolver), domain)
 	if err != nil {
 		return "", fmt.Errorf("get redirected domain: %w", err)
 	}
@@ -851,29 +876,29 @@ func GetRedirectedDomainFromDomain(domain string) (string, error) {
 	return redirectedDomain, nil
 }
 
-func getRedirectedDomain(url string) (string, error) {
-	client := &http.Client{
-		CheckRedirect: func(req *http.Request, via []*http.Request) error {
-			return http.ErrUseLastResponse
-		},
-	}
+// getRedirectedDomain returns the domain of the URL rawURL redirects to, following all the redirects with
+// DefaultRedirectResolver.
+func getRedirectedDomain(rawURL string) (string, error) {
+	return redirectedDomain(context.Background(), DefaultRedirectResolver, rawURL)
+}
 
-	resp, err := client.Head(url) //nolint:noctx
-	if err != nil {
-		return "", fmt.Errorf("failed to get redirection URL for %s: %w", url, err)
+// redirectedDomain returns the domain of the URL rawURL redirects to, following redirects with resolver. Once rawURL
+// redirected, failures of later hops are ignored, e.g. the target of a redirect to an unreachable host is known from
+// the Location header.
+func redirectedDomain(ctx context.Context, resolver *RedirectResolver, rawURL string) (string, error) {
+	result, err := resolver.Resolve(ctx, rawURL)
+	if err != nil && !result.Redirected() {
+		return "", fmt.Errorf("failed to get redirection URL for %s: %w", rawURL, err)
 	}
 
-	defer resp.Body.Close()
-
-	if resp.StatusCode < 300 || resp.StatusCode >= 400 {
-		return "", fmt.Errorf("no redirection found for %s with status code: %d, %w", url, resp.StatusCode, ErrNoRedirects)
+	if !result.Redirected() {
+		return "", fmt.Errorf("no redirection found for %s with status code: %d, %w", rawURL, result.StatusCode,
+			ErrNoRedirects)
 	}
 
-	redirectURL := resp.Header.Get("Location")
-
-	domain, err := DomainFromURLNoFiltering(redirectURL)
+	domain, err := DomainFromURLNoFiltering(result.URL, DomainFormASCII)
 	if err != nil {
-		return "", fmt.Errorf("failed to get domain from URL %s: %w", redirectURL, err)
+		return "", fmt.Errorf("failed to get domain from URL %s: %w", result.URL, err)
 	}
 
 	return domain, nil
@@ -881,10 +906,6 @@ func getRedirectedDomain(url string) (string, error) {
 
 const DefaultOmission = "…"
 
-var knownDomains = map[string]string{
-	"goo.gle": "google",
-}
-
 // Abbreviations which we don't want in contact first or last names.
 var abbrvs = "PH.D.|PHD|MD|CPA|CMA|PROF|PR|MBA|PHR|MA|BFA|PMP|MSM|TMP|RN|CFRE|PLS|MSW|CEC|HCS|CFP|AAMS|CLU|ChFC|M.P.A.|MLEC|MAQP|MSHR|SHRM-SCP|MG|MS|CSP|CAS|MAS|LDN|LPN|DC|JR|SR|CIR|A.C.C.|M.Ed.|M.A.I.|AI-GRS|JD|PE|CCP|CAA|LUTCF|FSS|MHR|FACS|MHA|PT|DPT|CDAL|CVM|LPC|CIC|SIOR|CPM|GC|CHHC|AADP|MPA|PE|BASI|CFRE|CMPE|FACHE|CAPS|CEPA|MSOM|IPMA-SCP|CME|ITIL|PMA|DR|II|III|IV|FRSA|F.R.S.A|LL.M.|CFA|MFE|CXAP"
 
@@ -910,7 +931,6 @@ var (
 	reLinkedinURL  = regexp.MustCompile(`http(s)?://([\w]+\.)?linkedin\.com/(pub|in|profile|company|school)/[^/?\s]+`)
 	rePlanName     = regexp.MustCompile("basic|starter|professional|enrich|business|entreprise|enterprise|pro|essential")
 	rePlanInterval = regexp.MustCompile("monthly|yearly")
-	reEmail        = regexp.MustCompile(`^[a-z0-9._%+\-]+@[a-z0-9.\-]+\.[a-z]{2,12}$`)
 	reNameSanitize = regexp.MustCompile(`\([^\)]*\)`)
 	reAbbrvPrefix  = regexp.MustCompile(`^(?i)((` + abbrvs + `)+[ |,|.]+)+`)
 	reAbbrvSuffix  = regexp.MustCompile(`(?i)([ |,|.]+(` + abbrvs + `))+$`)
@@ -280,6 +280,31 @@ func TestDomainFromURL(t *testing.T) {
 	}
 }
 
+func TestDomainFromURLBypassingShortenerContext(t *testing.T) {
+	t.Parallel()
+
+	server := startFakeShortenerServer(t, 0)
+
+	domain, err := DomainFromURLBypassingShortenerContext(context.Background(), server.resolver(), "http://bit.ly/abc")
+	require.NoError(t, err)
+	require.Equal(t, "acme.com", domain)
+
+	domain, err = DomainFromURLBypassingShortenerContext(context.Background(), server.resolver(), "http://bit.ly/dead")
+	require.ErrorIs(t, err, ErrNoRedirects)
+	require.Equal(t, "bit.ly", domain)
+
+	domain, err = DomainFromURLBypassingShortenerContext(context.Background(), server.resolver(),
+		"http://bit.ly/unreachable")
+	require.NoError(t, err)
+	require.Equal(t, "acme-unreachable.invalid", domain)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err = DomainFromURLBypassingShortenerContext(ctx, server.resolver(), "http://bit.ly/abc")
+	require.ErrorIs(t, err, context.Canceled)
+}
+
 func TestDomainFromURLNoFiltering(t *testing.T) {
 	t.Parallel()
 
@@ -401,6 +426,58 @@ func TestDomainFromURLNoFiltering(t *testing.T) {
 	}
 }
 
+func TestDomainNameWithoutTLD(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{input: "https://www.surfe.com/some-path", want: "surfe"},
+		{input: "www.surfe.com", want: "surfe"},
+		{input: "app.surfe.co.uk", want: "surfe"},
+		{input: "http://192.168.1.1", want: ""},
+		{input: "localhost", want: ""},
+		{input: "", want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tt.want, DomainNameWithoutTLD(tt.input))
+		})
+	}
+}
+
+func TestSubdomainWithDomainFromURL(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		input  string
+		want   string
+		hasErr bool
+	}{
+		{input: "https://www.surfe.com/some-path", want: "surfe.com"},
+		{input: "app.surfe.co.uk", want: "app.surfe.co.uk"},
+		{input: "https://www.app.surfe.com", want: "app.surfe.com"},
+		{input: "localhost", hasErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := SubdomainWithDomainFromURL(tt.input)
+			if tt.hasErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
 func TestFormatDomainURL(t *testing.T) {
 	t.Parallel()
 
@@ -439,6 +516,11 @@ func TestFormatDomainURL(t *testing.T) {
 			input: "xxxx",
 			want:  "xxxx",
 		},
+		{
+			name:  "Should return domain of URLs with another scheme",
+			input: "ftp://files.acme.com/x",
+			want:  "files.acme.com",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -585,19 +667,34 @@ func TestToValidEmail(t *testing.T) {
 	t.Parallel()
 
 	tests := []struct {
-		name  string
-		email string
-		want  bool
+		name     string
+		email    string
+		want     bool
+		expected string
 	}{
 		{
-			name:  "Valid email should return input email back and true",
-			email: "demo-pd@leadjet.com",
-			want:  true,
+			name:     "Valid email should return input email back and true",
+			email:    "demo-pd@leadjet.com",
+			want:     true,
+			expected: "demo-pd@leadjet.com",
+		},
+		{
+			name:     "Invalid email should return input email back and true",
+			email:    "demo-pd@leadjet",
+			want:     false,
+			expected: "demo-pd@leadjet",
+		},
+		{
+			name:     "Internationalized domain should return lowercased email and true",
+			email:    "Jean@Café.fr",
+			want:     true,
+			expected: "jean@café.fr",
 		},
 		{
-			name:  "Invalid email should return input email back and true",
-			email: "demo-pd@leadjet",
-			want:  false,
+			name:     "Email with display name should return lowercased email and false",
+			email:    "John <john@acme.com>",
+			want:     false,
+			expected: "john <john@acme.com>",
 		},
 	}
 	for _, tt := range tests {
@@ -606,7 +703,8 @@ func TestToValidEmail(t *testing.T) {
 
 			result, got := ToValidEmail(tt.email)
 			require.Equal(t, tt.want, got)
-			require.Equal(t, tt.email, result)
+			require.Equal(t, tt.expected, result)
+			require.Equal(t, got, IsEmailValid(tt.email))
 		})
 	}
 }
@@ -879,6 +977,11 @@ func TestURLHostnameExtractor(t *testing.T) {
 			str:  "http://surfe.com/",
 			want: "surfe.com",
 		},
+		{
+			name: "ftp with path",
+			str:  "ftp://files.acme.com/x",
+			want: "files.acme.com",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -1165,9 +1268,9 @@ func TestExtractHostAndPath(t *testing.T) {
 			wantURL: "linkedin.com/company/surfe",
 		},
 		{
-			name:    "Provided a URL without the scheme but including the w3 subdomain, it returns the same URL without trailing /",
+			name:    "Provided a URL without the scheme but including the w3 subdomain, it returns only the host+path, without the w3 subdomain and without trailing /",
 			fullURL: "www.linkedin.com/company/surfe/",
-			wantURL: "www.linkedin.com/company/surfe",
+			wantURL: "linkedin.com/company/surfe",
 		},
 		{
 			name:    "Provided a URL without the scheme, it returns the same URL without trailing /",
@@ -1184,6 +1287,11 @@ func TestExtractHostAndPath(t *testing.T) {
 			fullURL: "this is not an URL",
 			wantURL: "this is not an URL",
 		},
+		{
+			name:    "Provided a URL with another scheme, it returns only the host+path",
+			fullURL: "ftp://files.acme.com/x",
+			wantURL: "files.acme.com/x",
+		},
 		{
 			name:    "Provided an empty string, it returns an empty string without trailing /",
 			fullURL: "",
@@ -13,6 +13,7 @@ import (
 
 	"github.com/jinzhu/now"
 	"github.com/surfe/logger/v2"
+	"github.com/surfe/utils/phoneutils"
 )
 
 func TimeToString(t time.Time) string {
@@ -306,6 +307,24 @@ func GetTimezone(ctx context.Context, timezone string) string {
 	return timezone
 }
 
+// GetTimezoneFromPhone returns the most likely IANA timezone of phone, inferred offline from its prefix,
+// e.g. "America/Los_Angeles" for "+1 415 555 2671".
+// Phones without an international prefix are interpreted as national numbers of defaultRegion (ISO 3166-1 alpha-2).
+// It returns an empty string when phone can't be parsed or its region has no known timezone.
+func GetTimezoneFromPhone(ctx context.Context, phone, defaultRegion string) string {
+	number, err := phoneutils.Parse(phone, defaultRegion)
+	if err != nil {
+		return ""
+	}
+
+	timezones := phoneutils.Geolocate(number).Timezones
+	if len(timezones) == 0 {
+		return ""
+	}
+
+	return GetTimezone(ctx, timezones[0])
+}
+
 func GetTimeFromLinkedInSentTimeLabelAndUserTimeZone(ctx context.Context, sentTimeLabel, timezone string) *time.Time {
 	loc, err := time.LoadLocation(timezone)
 	if err != nil {
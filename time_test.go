@@ -118,6 +118,54 @@ func TestGetTimezone(t *testing.T) {
 	}
 }
 
+func TestGetTimezoneFromPhone(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		phone         string
+		defaultRegion string
+		expected      string
+	}{
+		{
+			name:     "US area code",
+			phone:    "+1 415 555 2671",
+			expected: "America/Los_Angeles",
+		},
+		{
+			name:          "National number of default region",
+			phone:         "01 42 68 53 00",
+			defaultRegion: "FR",
+			expected:      "Europe/Paris",
+		},
+		{
+			name:     "Canary Islands",
+			phone:    "+34 928 123 456",
+			expected: "Atlantic/Canary",
+		},
+		{
+			name:     "Region without known timezone",
+			phone:    "+976 1123 4567",
+			expected: "",
+		},
+		{
+			name:     "Not a phone",
+			phone:    "not found",
+			expected: "",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			result := GetTimezoneFromPhone(ctx, tc.phone, tc.defaultRegion)
+			require.Equal(t, tc.expected, result)
+		})
+	}
+}
+
 func TestGetTimeFromLinkedInSentTimeLabelAndUserTimeZone(t *testing.T) {
 	t.Parallel()
 
@@ -25,6 +25,11 @@ func TestIsPublicDomain(t *testing.T) {
 			domain: "wix.com",
 			want:   true,
 		},
+		{
+			name:   "Detects subdomains of website builders",
+			domain: "shop.wix.com",
+			want:   true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -36,3 +41,28 @@ func TestIsPublicDomain(t *testing.T) {
 		})
 	}
 }
+
+func TestIsURLShortenerDomain(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		domain string
+		want   bool
+	}{
+		{"bit.ly", true},
+		{"BIT.LY", true},
+		{"tinyurl.com", true},
+		{"surfe.com", false},
+		{"president.gov.tw", false},
+		{"acme.dy.fi", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.domain, func(t *testing.T) {
+			t.Parallel()
+
+			if got := IsURLShortenerDomain(tt.domain); got != tt.want {
+				t.Errorf("IsURLShortenerDomain() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
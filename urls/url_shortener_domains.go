@@ -1,2092 +0,0 @@
-package urls
-
-// https://github.com/PeterDaveHello/url-shorteners/raw/master/list
-var urlShortenerDomains = map[string]bool{
-	"0.gp":                 true,
-	"02faq.com":            true,
-	"0a.sk":                true,
-	"0rz.tw":               true,
-	"101.gg":               true,
-	"12ne.ws":              true,
-	"17mimei.club":         true,
-	"1drv.ms":              true,
-	"1ea.ir":               true,
-	"1kh.de":               true,
-	"1o2.ir":               true,
-	"1shop.io":             true,
-	"1u.fi":                true,
-	"1un.fr":               true,
-	"1url.cz":              true,
-	"2.gp":                 true,
-	"2.ht":                 true,
-	"2.ly":                 true,
-	"2doc.net":             true,
-	"2fear.com":            true,
-	"2kgam.es":             true,
-	"2link.cc":             true,
-	"2no.co":               true,
-	"2nu.gs":               true,
-	"2pl.us":               true,
-	"2u.lc":                true,
-	"2u.pw":                true,
-	"2wsb.tv":              true,
-	"3.cn":                 true,
-	"3.ly":                 true,
-	"301.link":             true,
-	"365edu.io":            true,
-	"3le.ru":               true,
-	"4.gp":                 true,
-	"4.ly":                 true,
-	"420.bio":              true,
-	"49rs.co":              true,
-	"4sq.com":              true,
-	"5.gp":                 true,
-	"52.nu":                true,
-	"53eig.ht":             true,
-	"5du.pl":               true,
-	"5w.fit":               true,
-	"6.gp":                 true,
-	"6.ly":                 true,
-	"69run.fun":            true,
-	"6g6.eu":               true,
-	"7.ly":                 true,
-	"707.su":               true,
-	"71a.xyz":              true,
-	"7news.link":           true,
-	"7ny.tv":               true,
-	"7oi.de":               true,
-	"8.ly":                 true,
-	"88nb.cc":              true,
-	"89q.sk":               true,
-	"8fig.me":              true,
-	"92url.com":            true,
-	"985.so":               true,
-	"98pro.cc":             true,
-	"9mp.com":              true,
-	"9nl.com":              true,
-	"9qr.de":               true,
-	"9splay.store":         true,
-	"a.189.cn":             true,
-	"a.co":                 true,
-	"a360.co":              true,
-	"aarp.info":            true,
-	"ab.co":                true,
-	"abc.li":               true,
-	"abc11.tv":             true,
-	"abc13.co":             true,
-	"abc7.la":              true,
-	"abc7.ws":              true,
-	"abc7ne.ws":            true,
-	"abcn.ws":              true,
-	"abe.ma":               true,
-	"abelinc.me":           true,
-	"abnb.me":              true,
-	"abr.ai":               true,
-	"abre.ai":              true,
-	"accntu.re":            true,
-	"acer.co":              true,
-	"acer.link":            true,
-	"aces.mp":              true,
-	"acortar.link":         true,
-	"act.gp":               true,
-	"acus.org":             true,
-	"adaymag.co":           true,
-	"adb.ug":               true,
-	"adbl.co":              true,
-	"adf.ly":               true,
-	"adfoc.us":             true,
-	"adm.to":               true,
-	"adobe.ly":             true,
-	"adol.us":              true,
-	"adweek.it":            true,
-	"aet.na":               true,
-	"agrd.io":              true,
-	"ai6.net":              true,
-	"aje.io":               true,
-	"aka.ms":               true,
-	"al.st":                true,
-	"alexa.design":         true,
-	"alleywat.ch":          true,
-	"alli.pub":             true,
-	"alnk.to":              true,
-	"alpha.camp":           true,
-	"alphab.gr":            true,
-	"alturl.com":           true,
-	"amays.im":             true,
-	"amba.to":              true,
-	"amc.film":             true,
-	"amex.co":              true,
-	"ampr.gs":              true,
-	"amrep.org":            true,
-	"amz.run":              true,
-	"amzn.com":             true,
-	"amzn.pw":              true,
-	"amzn.to":              true,
-	"ana.ms":               true,
-	"anch.co":              true,
-	"ancstry.me":           true,
-	"andauth.co":           true,
-	"anon.to":              true,
-	"anyimage.io":          true,
-	"aol.it":               true,
-	"aon.io":               true,
-	"apne.ws":              true,
-	"app.philz.us":         true,
-	"apple.co":             true,
-	"apple.news":           true,
-	"aptg.tw":              true,
-	"arah.in":              true,
-	"arc.ht":               true,
-	"arkinv.st":            true,
-	"asics.tv":             true,
-	"asin.cc":              true,
-	"asq.kr":               true,
-	"asus.click":           true,
-	"at.vibe.com":          true,
-	"atm.tk":               true,
-	"atmilb.com":           true,
-	"atmlb.com":            true,
-	"atres.red":            true,
-	"autode.sk":            true,
-	"avlne.ws":             true,
-	"avlr.co":              true,
-	"avydn.co":             true,
-	"axios.link":           true,
-	"axoni.us":             true,
-	"ay.gy":                true,
-	"azc.cc":               true,
-	"b-gat.es":             true,
-	"b.link":               true,
-	"b.mw":                 true,
-	"b23.ru":               true,
-	"b23.tv":               true,
-	"b2n.ir":               true,
-	"baratun.de":           true,
-	"bayareane.ws":         true,
-	"bbc.in":               true,
-	"bbva.info":            true,
-	"bc.vc":                true,
-	"bca.id":               true,
-	"bcene.ws":             true,
-	"bcove.video":          true,
-	"bcsite.io":            true,
-	"bddy.me":              true,
-	"beats.is":             true,
-	"benqurl.biz":          true,
-	"beth.games":           true,
-	"bfpne.ws":             true,
-	"bg4.me":               true,
-	"bhpho.to":             true,
-	"bigcc.cc":             true,
-	"bigfi.sh":             true,
-	"biggo.tw":             true,
-	"biibly.com":           true,
-	"binged.it":            true,
-	"bit.ly":               true,
-	"bitly.com":            true,
-	"bitly.is":             true,
-	"bitly.lc":             true,
-	"bl.ink":               true,
-	"blap.net":             true,
-	"blbrd.cm":             true,
-	"blck.by":              true,
-	"blizz.ly":             true,
-	"bloom.bg":             true,
-	"blstg.news":           true,
-	"blur.by":              true,
-	"bmai.cc":              true,
-	"bnds.in":              true,
-	"bnetwhk.com":          true,
-	"bo.st":                true,
-	"boa.la":               true,
-	"boile.rs":             true,
-	"bom.so":               true,
-	"bonap.it":             true,
-	"booki.ng":             true,
-	"bookstw.link":         true,
-	"bose.life":            true,
-	"boston25.com":         true,
-	"bp.cool":              true,
-	"br4.in":               true,
-	"bravo.ly":             true,
-	"bridge.dev":           true,
-	"brief.ly":             true,
-	"brook.gs":             true,
-	"browser.to":           true,
-	"bst.bz":               true,
-	"bstk.me":              true,
-	"bsun.md":              true,
-	"btm.li":               true,
-	"btwrdn.com":           true,
-	"budurl.com":           true,
-	"buff.ly":              true,
-	"bung.ie":              true,
-	"bwnews.pr":            true,
-	"by2.io":               true,
-	"bytl.fr":              true,
-	"bzfd.it":              true,
-	"bzh.me":               true,
-	"c11.kr":               true,
-	"c87.to":               true,
-	"cadill.ac":            true,
-	"can.al":               true,
-	"canon.us":             true,
-	"capital.one":          true,
-	"capitalfm.co":         true,
-	"captl1.co":            true,
-	"careem.me":            true,
-	"caro.sl":              true,
-	"cart.mn":              true,
-	"casio.link":           true,
-	"cathaybk.tw":          true,
-	"cathaysec.tw":         true,
-	"cb.com":               true,
-	"cbj.co":               true,
-	"cbsloc.al":            true,
-	"cbsn.ws":              true,
-	"cbt.gg":               true,
-	"cc.cc":                true,
-	"cdl.booksy.com":       true,
-	"cfl.re":               true,
-	"chilp.it":             true,
-	"chip.tl":              true,
-	"chl.li":               true,
-	"chn.ge":               true,
-	"chn.lk":               true,
-	"chng.it":              true,
-	"chts.tw":              true,
-	"chzb.gr":              true,
-	"cin.ci":               true,
-	"cindora.club":         true,
-	"circle.ci":            true,
-	"cirk.me":              true,
-	"cisn.co":              true,
-	"citi.asia":            true,
-	"cjky.it":              true,
-	"ckbe.at":              true,
-	"cl.ly":                true,
-	"clarobr.co":           true,
-	"clc.am":               true,
-	"clc.to":               true,
-	"clck.ru":              true,
-	"cle.clinic":           true,
-	"cli.re":               true,
-	"clickmeter.com":       true,
-	"clicky.me":            true,
-	"clr.tax":              true,
-	"clvr.li":              true,
-	"clvr.rocks":           true,
-	"cmon.co":              true,
-	"cmu.is":               true,
-	"cmy.tw":               true,
-	"cna.asia":             true,
-	"cnb.cx":               true,
-	"cnet.co":              true,
-	"cnfl.io":              true,
-	"cnn.it":               true,
-	"cnnmon.ie":            true,
-	"cnvrge.co":            true,
-	"cockroa.ch":           true,
-	"comca.st":             true,
-	"conta.cc":             true,
-	"cookcenter.info":      true,
-	"coop.uk":              true,
-	"cort.as":              true,
-	"coupa.ng":             true,
-	"cplink.co":            true,
-	"cr.ma":                true,
-	"cr8.lv":               true,
-	"crackm.ag":            true,
-	"crdrv.co":             true,
-	"credicard.biz":        true,
-	"crwd.fr":              true,
-	"crwd.in":              true,
-	"crwdstr.ke":           true,
-	"cs.co":                true,
-	"csmo.us":              true,
-	"cstu.io":              true,
-	"ctbc.tw":              true,
-	"ctfl.io":              true,
-	"cultm.ac":             true,
-	"cup.org":              true,
-	"cut.lu":               true,
-	"cut.pe":               true,
-	"cutt.ly":              true,
-	"cutt.us":              true,
-	"cvent.me":             true,
-	"cvs.co":               true,
-	"cyb.ec":               true,
-	"cybr.rocks":           true,
-	"d-sh.io":              true,
-	"da.gd":                true,
-	"dai.ly":               true,
-	"dailym.ai":            true,
-	"dainik-b.in":          true,
-	"datayi.cn":            true,
-	"davidbombal.wiki":     true,
-	"db.tt":                true,
-	"dbricks.co":           true,
-	"dcps.co":              true,
-	"dd.ma":                true,
-	"deb.li":               true,
-	"dee.pl":               true,
-	"deli.bz":              true,
-	"dell.to":              true,
-	"deloi.tt":             true,
-	"dems.me":              true,
-	"derpy.me":             true,
-	"dhk.gg":               true,
-	"di.sn":                true,
-	"dibb.me":              true,
-	"dis.gd":               true,
-	"dis.tl":               true,
-	"discord.gg":           true,
-	"discvr.co":            true,
-	"disq.us":              true,
-	"dive.pub":             true,
-	"djex.co":              true,
-	"dk.rog.gg":            true,
-	"dkng.co":              true,
-	"dkng.me":              true,
-	"dky.bz":               true,
-	"dl.gl":                true,
-	"dld.bz":               true,
-	"dlsh.it":              true,
-	"dlvr.it":              true,
-	"dmdi.pl":              true,
-	"dmreg.co":             true,
-	"do.co":                true,
-	"dockr.ly":             true,
-	"dopice.sk":            true,
-	"dpmd.ai":              true,
-	"dpo.st":               true,
-	"drexe.lu":             true,
-	"dssurl.com":           true,
-	"dtdg.co":              true,
-	"dtsx.io":              true,
-	"dub.sh":               true,
-	"dv.gd":                true,
-	"dvrv.ai":              true,
-	"dw.com":               true,
-	"dwz.tax":              true,
-	"dxc.to":               true,
-	"dy.fi":                true,
-	"dy.si":                true,
-	"e.lilly":              true,
-	"e.vg":                 true,
-	"ebay.to":              true,
-	"econ.st":              true,
-	"ed.gr":                true,
-	"edin.ac":              true,
-	"edu.nl":               true,
-	"eepurl.com":           true,
-	"efshop.tw":            true,
-	"ek.tech":              true,
-	"ela.st":               true,
-	"elle.re":              true,
-	"ellemag.co":           true,
-	"embt.co":              true,
-	"emirat.es":            true,
-	"engt.co":              true,
-	"enshom.link":          true,
-	"entm.ag":              true,
-	"envs.sh":              true,
-	"eonli.ne":             true,
-	"epochtim.es":          true,
-	"ept.ms":               true,
-	"eqix.it":              true,
-	"es.pn":                true,
-	"es.rog.gg":            true,
-	"escape.to":            true,
-	"esl.gg":               true,
-	"eslite.me":            true,
-	"esqr.co":              true,
-	"esun.co":              true,
-	"etoro.tw":             true,
-	"etp.tw":               true,
-	"etsy.me":              true,
-	"everri.ch":            true,
-	"exe.io":               true,
-	"exitl.ag":             true,
-	"ezstat.ru":            true,
-	"f1.com":               true,
-	"f5yo.com":             true,
-	"fa.by":                true,
-	"fal.cn":               true,
-	"fam.ag":               true,
-	"fandan.co":            true,
-	"fandom.link":          true,
-	"fandw.me":             true,
-	"faras.link":           true,
-	"faturl.com":           true,
-	"fav.me":               true,
-	"fave.co":              true,
-	"fb.me":                true,
-	"fb.watch":             true,
-	"fbstw.link":           true,
-	"fce.gg":               true,
-	"fetnet.tw":            true,
-	"fevo.me":              true,
-	"ff.im":                true,
-	"fifa.fans":            true,
-	"firsturl.de":          true,
-	"firsturl.net":         true,
-	"flic.kr":              true,
-	"flip.it":              true,
-	"flomuz.io":            true,
-	"flq.us":               true,
-	"fltr.ai":              true,
-	"flx.to":               true,
-	"fmurl.cc":             true,
-	"fn.gg":                true,
-	"fnb.lc":               true,
-	"foodtv.com":           true,
-	"fooji.info":           true,
-	"ford.to":              true,
-	"forms.gle":            true,
-	"forr.com":             true,
-	"found.ee":             true,
-	"fox.tv":               true,
-	"fr.rog.gg":            true,
-	"frdm.mobi":            true,
-	"fstrk.cc":             true,
-	"ftnt.net":             true,
-	"fumacrom.com":         true,
-	"fvrr.co":              true,
-	"fwme.eu":              true,
-	"fxn.ws":               true,
-	"g-web.in":             true,
-	"g.asia":               true,
-	"g.co":                 true,
-	"g.page":               true,
-	"g02.ir":               true,
-	"ga.co":                true,
-	"galien.org":           true,
-	"gandi.link":           true,
-	"garyvee.com":          true,
-	"gaw.kr":               true,
-	"gbod.org":             true,
-	"gbpg.net":             true,
-	"gbte.tech":            true,
-	"gclnk.com":            true,
-	"gdurl.com":            true,
-	"gek.link":             true,
-	"gen.cat":              true,
-	"geni.us":              true,
-	"genie.co.kr":          true,
-	"getf.ly":              true,
-	"geti.in":              true,
-	"gfuel.ly":             true,
-	"gg.gg":                true,
-	"gh.io":                true,
-	"ghkp.us":              true,
-	"gi.lt":                true,
-	"gigaz.in":             true,
-	"github.co":            true,
-	"gizmo.do":             true,
-	"gjk.id":               true,
-	"glbe.co":              true,
-	"glblctzn.co":          true,
-	"glblctzn.me":          true,
-	"gldr.co":              true,
-	"glmr.co":              true,
-	"glo.bo":               true,
-	"glo.li":               true,
-	"gma.abc":              true,
-	"gmj.tw":               true,
-	"go.aws":               true,
-	"go.btwrdn.co":         true,
-	"go.cwtv.com":          true,
-	"go.dbs.com":           true,
-	"go.edh.tw":            true,
-	"go.gcash.com":         true,
-	"go.hny.co":            true,
-	"go.id.me":             true,
-	"go.intel-academy.com": true,
-	"go.intigriti.com":     true,
-	"go.lamotte.fr":        true,
-	"go.lu-h.de":           true,
-	"go.ly":                true,
-	"go.mend.io":           true,
-	"go.nasa.gov":          true,
-	"go.nowth.is":          true,
-	"go.osu.edu":           true,
-	"go.qb.by":             true,
-	"go.rebel.pl":          true,
-	"go.shell.com":         true,
-	"go.shr.lc":            true,
-	"go.sony.tw":           true,
-	"go.tinder.com":        true,
-	"go.usa.gov":           true,
-	"go.ustwo.games":       true,
-	"go.vic.gov.au":        true,
-	"godrk.de":             true,
-	"gofund.me":            true,
-	"gomomento.co":         true,
-	"goo-gl.me":            true,
-	"goo.by":               true,
-	"goo.gl":               true,
-	"goo.su":               true,
-	"goolink.cc":           true,
-	"goolnk.com":           true,
-	"gosm.link":            true,
-	"got.cr":               true,
-	"got.to":               true,
-	"gov.tw":               true,
-	"gowat.ch":             true,
-	"gph.to":               true,
-	"gq.mn":                true,
-	"gr.pn":                true,
-	"grb.to":               true,
-	"grdt.ai":              true,
-	"grhb.me":              true,
-	"grm.my":               true,
-	"grnh.se":              true,
-	"gtly.ink":             true,
-	"gtly.to":              true,
-	"gtne.ws":              true,
-	"gtnr.it":              true,
-	"gym.sh":               true,
-	"haa.su":               true,
-	"han.gl":               true,
-	"hashi.co":             true,
-	"hbaz.co":              true,
-	"hbom.ax":              true,
-	"her.is":               true,
-	"herff.ly":             true,
-	"hf.co":                true,
-	"hi.kktv.to":           true,
-	"hi.sat.cool":          true,
-	"hi.switchy.io":        true,
-	"hicider.com":          true,
-	"hideout.cc":           true,
-	"hill.cm":              true,
-	"histori.ca":           true,
-	"hit.kg":               true,
-	"hmt.ai":               true,
-	"hnsl.mn":              true,
-	"homes.jp":             true,
-	"hp.care":              true,
-	"hpe.to":               true,
-	"hrbl.me":              true,
-	"href.li":              true,
-	"ht.ly":                true,
-	"htgb.co":              true,
-	"htl.li":               true,
-	"htn.to":               true,
-	"httpslink.com":        true,
-	"hub.am":               true,
-	"hubs.la":              true,
-	"hubs.li":              true,
-	"hubs.ly":              true,
-	"huffp.st":             true,
-	"hulu.tv":              true,
-	"huma.na":              true,
-	"hyp.ae":               true,
-	"hyperurl.co":          true,
-	"hyperx.gg":            true,
-	"i-d.co":               true,
-	"i.coscup.org":         true,
-	"i.mtr.cool":           true,
-	"ibb.co":               true,
-	"ibf.tw":               true,
-	"ibit.ly":              true,
-	"ibm.biz":              true,
-	"ibm.co":               true,
-	"ic9.in":               true,
-	"icit.fr":              true,
-	"icks.ro":              true,
-	"iea.li":               true,
-	"ifix.gd":              true,
-	"ift.tt":               true,
-	"iherb.co":             true,
-	"ihr.fm":               true,
-	"ii1.su":               true,
-	"iii.im":               true,
-	"iiil.io":              true,
-	"il.rog.gg":            true,
-	"ilang.in":             true,
-	"illin.is":             true,
-	"iln.io":               true,
-	"ilnk.io":              true,
-	"imdb.to":              true,
-	"ind.pn":               true,
-	"indeedhi.re":          true,
-	"indy.st":              true,
-	"infy.com":             true,
-	"inlnk.ru":             true,
-	"insd.io":              true,
-	"insig.ht":             true,
-	"instagr.am":           true,
-	"intel.ly":             true,
-	"interc.pt":            true,
-	"intuit.me":            true,
-	"invent.ge":            true,
-	"inx.lv":               true,
-	"ionos.ly":             true,
-	"ipgrabber.ru":         true,
-	"ipgraber.ru":          true,
-	"iplogger.co":          true,
-	"iplogger.com":         true,
-	"iplogger.info":        true,
-	"iplogger.org":         true,
-	"iplogger.ru":          true,
-	"iplwin.us":            true,
-	"iqiyi.cn":             true,
-	"irng.ca":              true,
-	"is.gd":                true,
-	"ishort.ink":           true,
-	"isw.pub":              true,
-	"itsfoss.click":        true,
-	"itsh.bo":              true,
-	"itvty.com":            true,
-	"ity.im":               true,
-	"ix.sk":                true,
-	"j.gs":                 true,
-	"j.mp":                 true,
-	"ja.cat":               true,
-	"ja.ma":                true,
-	"jb.gg":                true,
-	"jcp.is":               true,
-	"jkf.lv":               true,
-	"jnfusa.org":           true,
-	"joo.gl":               true,
-	"jp.rog.gg":            true,
-	"jpeg.ly":              true,
-	"jsparty.fm":           true,
-	"k-p.li":               true,
-	"kas.pr":               true,
-	"kask.us":              true,
-	"katzr.net":            true,
-	"kbank.co":             true,
-	"kbit.co":              true,
-	"kck.st":               true,
-	"kf.org":               true,
-	"kfrc.co":              true,
-	"kg.games":             true,
-	"kgs.link":             true,
-	"kham.tw":              true,
-	"kings.tn":             true,
-	"kkc.tech":             true,
-	"kkday.me":             true,
-	"kkne.ws":              true,
-	"kko.to":               true,
-	"kkstre.am":            true,
-	"kl.ik.my":             true,
-	"klck.me":              true,
-	"kli.cx":               true,
-	"klmf.ly":              true,
-	"knw.one":              true,
-	"ko.gl":                true,
-	"kortlink.dk":          true,
-	"kotl.in":              true,
-	"kp.org":               true,
-	"kpmg.ch":              true,
-	"krazy.la":             true,
-	"ku.ag":                true,
-	"kuku.lu":              true,
-	"kurl.ru":              true,
-	"kutt.it":              true,
-	"ky77.link":            true,
-	"l-i-nk.me":            true,
-	"l.linklyhq.com":       true,
-	"l.prageru.com":        true,
-	"l8r.it":               true,
-	"laco.st":              true,
-	"lam.bo":               true,
-	"lat.ms":               true,
-	"latingram.my":         true,
-	"lativ.tw":             true,
-	"lbtw.tw":              true,
-	"lc.cx":                true,
-	"learn.to":             true,
-	"lego.build":           true,
-	"lemde.fr":             true,
-	"letsharu.cc":          true,
-	"lft.to":               true,
-	"lih.kg":               true,
-	"lihi.biz":             true,
-	"lihi.cc":              true,
-	"lihi.one":             true,
-	"lihi.pro":             true,
-	"lihi.tv":              true,
-	"lihi.vip":             true,
-	"lihi1.cc":             true,
-	"lihi1.com":            true,
-	"lihi1.me":             true,
-	"lihi2.cc":             true,
-	"lihi2.com":            true,
-	"lihi2.me":             true,
-	"lihi3.cc":             true,
-	"lihi3.com":            true,
-	"lihi3.me":             true,
-	"lihipro.com":          true,
-	"lihivip.com":          true,
-	"liip.to":              true,
-	"lin.ee":               true,
-	"lin0.de":              true,
-	"link.ac":              true,
-	"link.gy":              true,
-	"link.infini.fr":       true,
-	"link.tubi.tv":         true,
-	"linkbun.com":          true,
-	"linkd.in":             true,
-	"linkjust.com":         true,
-	"linko.page":           true,
-	"linkopener.co":        true,
-	"links2.me":            true,
-	"linkshare.pro":        true,
-	"linkye.net":           true,
-	"livemu.sc":            true,
-	"livestre.am":          true,
-	"llk.dk":               true,
-	"llo.to":               true,
-	"lmg.gg":               true,
-	"lmt.co":               true,
-	"lmy.de":               true,
-	"lnk.bz":               true,
-	"lnk.direct":           true,
-	"lnk.do":               true,
-	"lnk.sk":               true,
-	"lnkd.in":              true,
-	"lnkiy.com":            true,
-	"lnkiy.in":             true,
-	"lnky.jp":              true,
-	"lnnk.in":              true,
-	"lnv.gy":               true,
-	"lohud.us":             true,
-	"lonerwolf.co":         true,
-	"loom.ly":              true,
-	"low.es":               true,
-	"lprk.co":              true,
-	"lru.jp":               true,
-	"lsdl.es":              true,
-	"lstu.fr":              true,
-	"lt27.de":              true,
-	"lttr.ai":              true,
-	"ludia.gg":             true,
-	"luminary.link":        true,
-	"lurl.cc":              true,
-	"lyksoomu.com":         true,
-	"lzd.co":               true,
-	"m.me":                 true,
-	"m.tb.cn":              true,
-	"m101.org":             true,
-	"m1p.fr":               true,
-	"m6z.cn":               true,
-	"maac.io":              true,
-	"maga.lu":              true,
-	"man.ac.uk":            true,
-	"many.at":              true,
-	"maper.info":           true,
-	"mapfan.to":            true,
-	"mayocl.in":            true,
-	"mbapp.io":             true,
-	"mbayaq.co":            true,
-	"mcafee.ly":            true,
-	"mcd.to":               true,
-	"mcgam.es":             true,
-	"mck.co":               true,
-	"mcys.co":              true,
-	"me.sv":                true,
-	"me2.kr":               true,
-	"meck.co":              true,
-	"meetu.ps":             true,
-	"merky.de":             true,
-	"metamark.net":         true,
-	"mgnet.me":             true,
-	"mgstn.ly":             true,
-	"michmed.org":          true,
-	"migre.me":             true,
-	"minify.link":          true,
-	"minilink.io":          true,
-	"mitsha.re":            true,
-	"mklnd.com":            true,
-	"mm.rog.gg":            true,
-	"mmz.li":               true,
-	"mney.co":              true,
-	"mng.bz":               true,
-	"mnge.it":              true,
-	"mnot.es":              true,
-	"mo.ma":                true,
-	"momo.dm":              true,
-	"monster.cat":          true,
-	"moo.im":               true,
-	"moovit.me":            true,
-	"mork.ro":              true,
-	"mou.sr":               true,
-	"mpl.pm":               true,
-	"mrte.ch":              true,
-	"mrx.cl":               true,
-	"ms.spr.ly":            true,
-	"msft.it":              true,
-	"msi.gm":               true,
-	"mstr.cd":              true,
-	"mstr.cl":              true,
-	"mttr.io":              true,
-	"mtw.so":               true,
-	"mub.me":               true,
-	"munbyn.biz":           true,
-	"mvmtwatch.co":         true,
-	"my.mtr.cool":          true,
-	"mybmw.tw":             true,
-	"myglamm.in":           true,
-	"mylt.tv":              true,
-	"mypoya.com":           true,
-	"myppt.cc":             true,
-	"mysp.ac":              true,
-	"myumi.ch":             true,
-	"myurls.ca":            true,
-	"mz.cm":                true,
-	"mzl.la":               true,
-	"n.opn.tl":             true,
-	"n.pr":                 true,
-	"n9.cl":                true,
-	"name.ly":              true,
-	"nature.ly":            true,
-	"nav.cx":               true,
-	"naver.me":             true,
-	"nbc4dc.com":           true,
-	"nbcbay.com":           true,
-	"nbcchi.com":           true,
-	"nbcct.co":             true,
-	"nbcnews.to":           true,
-	"nbzp.cz":              true,
-	"nchcnh.info":          true,
-	"nej.md":               true,
-	"neti.cc":              true,
-	"netm.ag":              true,
-	"nflx.it":              true,
-	"ngrid.com":            true,
-	"njersy.co":            true,
-	"nkbp.jp":              true,
-	"nkf.re":               true,
-	"nmrk.re":              true,
-	"nnn.is":               true,
-	"nnna.ru":              true,
-	"nnnna.store":          true,
-	"nokia.ly":             true,
-	"notlong.com":          true,
-	"nr.tn":                true,
-	"nswroads.work":        true,
-	"ntap.com":             true,
-	"ntck.co":              true,
-	"ntn.so":               true,
-	"ntnx.tw":              true,
-	"ntuc.co":              true,
-	"nus.edu":              true,
-	"nvda.ws":              true,
-	"nwppr.co":             true,
-	"nwsdy.li":             true,
-	"nxb.tw":               true,
-	"nxdr.co":              true,
-	"nycu.to":              true,
-	"nydn.us":              true,
-	"nyer.cm":              true,
-	"nym.ag":               true,
-	"nyp.st":               true,
-	"nyr.kr":               true,
-	"nyti.ms":              true,
-	"o.vg":                 true,
-	"oal.lu":               true,
-	"obank.tw":             true,
-	"oc.cm":                true,
-	"ock.cn":               true,
-	"ocul.us":              true,
-	"oe.cd":                true,
-	"ofcour.se":            true,
-	"offerup.co":           true,
-	"offf.to":              true,
-	"offs.ec":              true,
-	"okt.to":               true,
-	"omni.ag":              true,
-	"on.bcg.com":           true,
-	"on.bp.com":            true,
-	"on.fb.me":             true,
-	"on.ft.com":            true,
-	"on.louisvuitton.com":  true,
-	"on.mktw.net":          true,
-	"on.natgeo.com":        true,
-	"on.nba.com":           true,
-	"on.ny.gov":            true,
-	"on.nyc.gov":           true,
-	"on.nypl.org":          true,
-	"on.tcs.com":           true,
-	"on.wsj.com":           true,
-	"on9news.tv":           true,
-	"onelink.to":           true,
-	"onepl.us":             true,
-	"onforb.es":            true,
-	"onion.com":            true,
-	"onx.la":               true,
-	"opr.as":               true,
-	"opr.news":             true,
-	"optimize.ly":          true,
-	"oran.ge":              true,
-	"orlo.uk":              true,
-	"osdb.link":            true,
-	"oshko.sh":             true,
-	"ouo.io":               true,
-	"ouo.press":            true,
-	"ourl.co":              true,
-	"ourl.in":              true,
-	"ourl.tw":              true,
-	"outschooler.me":       true,
-	"ovh.to":               true,
-	"ow.ly":                true,
-	"owl.li":               true,
-	"owy.mn":               true,
-	"oxelt.gl":             true,
-	"oxf.am":               true,
-	"oyn.at":               true,
-	"p.asia":               true,
-	"p.dw.com":             true,
-	"p1r.es":               true,
-	"p4k.in":               true,
-	"pa.ag":                true,
-	"packt.link":           true,
-	"pag.la":               true,
-	"parg.co":              true,
-	"pchome.link":          true,
-	"pck.tv":               true,
-	"pdora.co":             true,
-	"pdxint.at":            true,
-	"pe.ga":                true,
-	"pens.pe":              true,
-	"peoplem.ag":           true,
-	"pepsi.co":             true,
-	"pesc.pw":              true,
-	"petrobr.as":           true,
-	"pew.org":              true,
-	"pewrsr.ch":            true,
-	"pg3d.app":             true,
-	"pgat.us":              true,
-	"pgrs.in":              true,
-	"philips.to":           true,
-	"piee.pw":              true,
-	"pin.it":               true,
-	"pipr.es":              true,
-	"pj.pizza":             true,
-	"pl.kotl.in":           true,
-	"pldthome.info":        true,
-	"plu.sh":               true,
-	"pnsne.ws":             true,
-	"pod.fo":               true,
-	"poie.ma":              true,
-	"pojonews.co":          true,
-	"politi.co":            true,
-	"popm.ch":              true,
-	"posh.mk":              true,
-	"pplx.ai":              true,
-	"ppt.cc":               true,
-	"ppurl.io":             true,
-	"pr.tn":                true,
-	"prbly.us":             true,
-	"prdct.school":         true,
-	"preml.ge":             true,
-	"prf.hn":               true,
-	"prgress.co":           true,
-	"prn.to":               true,
-	"propub.li":            true,
-	"pros.is":              true,
-	"psce.pw":              true,
-	"pse.is":               true,
-	"psee.io":              true,
-	"pt.rog.gg":            true,
-	"ptix.co":              true,
-	"puext.in":             true,
-	"purdue.university":    true,
-	"purefla.sh":           true,
-	"puri.na":              true,
-	"pwc.to":               true,
-	"pxgo.net":             true,
-	"pxu.co":               true,
-	"pzdls.co":             true,
-	"q.gs":                 true,
-	"qnap.to":              true,
-	"qptr.ru":              true,
-	"qr.ae":                true,
-	"qr.net":               true,
-	"qrco.de":              true,
-	"qrs.ly":               true,
-	"qvc.co":               true,
-	"r-7.co":               true,
-	"r.zecz.ec":            true,
-	"rb.gy":                true,
-	"rbl.ms":               true,
-	"rblx.co":              true,
-	"rch.lt":               true,
-	"rd.gt":                true,
-	"rdbl.co":              true,
-	"rdcrss.org":           true,
-	"rdcu.be":              true,
-	"rdre.me":              true,
-	"read.bi":              true,
-	"readhacker.news":      true,
-	"rebelne.ws":           true,
-	"rebrand.ly":           true,
-	"reconis.co":           true,
-	"red.ht":               true,
-	"redaz.in":             true,
-	"redd.it":              true,
-	"redir.ec":             true,
-	"redir.is":             true,
-	"redsto.ne":            true,
-	"redtag.la":            true,
-	"ref.trade.re":         true,
-	"referer.us":           true,
-	"refini.tv":            true,
-	"regmovi.es":           true,
-	"reline.cc":            true,
-	"relink.asia":          true,
-	"rem.ax":               true,
-	"renew.ge":             true,
-	"replug.link":          true,
-	"rethinktw.cc":         true,
-	"reurl.cc":             true,
-	"reut.rs":              true,
-	"rev.cm":               true,
-	"revr.ec":              true,
-	"rfr.bz":               true,
-	"ringcentr.al":         true,
-	"riot.com":             true,
-	"rip.city":             true,
-	"risu.io":              true,
-	"ritea.id":             true,
-	"rizy.ir":              true,
-	"rlu.ru":               true,
-	"rly.pt":               true,
-	"rnm.me":               true,
-	"ro.blox.com":          true,
-	"rog.gg":               true,
-	"roge.rs":              true,
-	"rol.st":               true,
-	"rotf.lol":             true,
-	"rozhl.as":             true,
-	"rpf.io":               true,
-	"rptl.io":              true,
-	"rsc.li":               true,
-	"rsh.md":               true,
-	"rtvote.com":           true,
-	"ru.rog.gg":            true,
-	"rushgiving.com":       true,
-	"rushtix.co":           true,
-	"rvtv.io":              true,
-	"rvwd.co":              true,
-	"rwl.io":               true,
-	"ryml.me":              true,
-	"rzr.to":               true,
-	"s.accupass.com":       true,
-	"s.coop":               true,
-	"s.g123.jp":            true,
-	"s.id":                 true,
-	"s.mj.run":             true,
-	"s.ul.com":             true,
-	"s.uniqlo.com":         true,
-	"s.wikicharlie.cl":     true,
-	"s04.de":               true,
-	"s3vip.tw":             true,
-	"saf.li":               true,
-	"safelinking.net":      true,
-	"safl.it":              true,
-	"sail.to":              true,
-	"samcart.me":           true,
-	"sbird.co":             true,
-	"sbux.co":              true,
-	"sbux.jp":              true,
-	"sc.mp":                true,
-	"sc.org":               true,
-	"sched.co":             true,
-	"sck.io":               true,
-	"scr.bi":               true,
-	"scrb.ly":              true,
-	"scuf.co":              true,
-	"sdpbne.ws":            true,
-	"sdu.sk":               true,
-	"sdut.us":              true,
-	"se.rog.gg":            true,
-	"seagate.media":        true,
-	"sealed.in":            true,
-	"seedsta.rs":           true,
-	"seiu.co":              true,
-	"sejr.nl":              true,
-	"selnd.com":            true,
-	"seolrea.net":          true,
-	"seph.me":              true,
-	"seq.vc":               true,
-	"sf3c.tw":              true,
-	"sfca.re":              true,
-	"sfcne.ws":             true,
-	"sforce.co":            true,
-	"sfty.io":              true,
-	"sgq.io":               true,
-	"shar.as":              true,
-	"shiny.link":           true,
-	"shln.me":              true,
-	"sho.pe":               true,
-	"shope.ee":             true,
-	"shorl.com":            true,
-	"short.gy":             true,
-	"shorten.asia":         true,
-	"shorturl.ae":          true,
-	"shorturl.asia":        true,
-	"shorturl.at":          true,
-	"shorturl.com":         true,
-	"shorturl.gg":          true,
-	"shp.ee":               true,
-	"shrtco.de":            true,
-	"shrtm.nu":             true,
-	"sht.moe":              true,
-	"shutr.bz":             true,
-	"sie.ag":               true,
-	"simp.ly":              true,
-	"sina.lt":              true,
-	"sincere.ly":           true,
-	"sinourl.tw":           true,
-	"sinyi.biz":            true,
-	"sinyi.in":             true,
-	"siriusxm.us":          true,
-	"siteco.re":            true,
-	"skimmth.is":           true,
-	"skl.sh":               true,
-	"skr.sk":               true,
-	"skrat.it":             true,
-	"skyurl.cc":            true,
-	"slidesha.re":          true,
-	"small.cat":            true,
-	"smart.link":           true,
-	"smarturl.it":          true,
-	"smashed.by":           true,
-	"smbz.us":              true,
-	"smlk.es":              true,
-	"smonty.co":            true,
-	"smsb.co":              true,
-	"smsng.news":           true,
-	"smsng.us":             true,
-	"smtvj.com":            true,
-	"smu.gs":               true,
-	"sn.cf":                true,
-	"snd.sc":               true,
-	"sndn.link":            true,
-	"snip.link":            true,
-	"snip.ly":              true,
-	"snyk.co":              true,
-	"so.arte":              true,
-	"soc.cr":               true,
-	"soch.us":              true,
-	"social.ora.cl":        true,
-	"socx.in":              true,
-	"sokrati.ru":           true,
-	"solsn.se":             true,
-	"sou.nu":               true,
-	"sourl.cn":             true,
-	"sovrn.co":             true,
-	"spcne.ws":             true,
-	"spgrp.sg":             true,
-	"spigen.co":            true,
-	"split.to":             true,
-	"splk.it":              true,
-	"spoti.fi":             true,
-	"spotify.link":         true,
-	"spr.ly":               true,
-	"spr.tn":               true,
-	"sprtsnt.ca":           true,
-	"sptfy.com":            true,
-	"sqex.to":              true,
-	"sqrx.io":              true,
-	"squ.re":               true,
-	"srnk.us":              true,
-	"ssur.cc":              true,
-	"st.news":              true,
-	"st8.fm":               true,
-	"stan.md":              true,
-	"stanford.io":          true,
-	"starz.tv":             true,
-	"stmodel.com":          true,
-	"storycor.ps":          true,
-	"stspg.io":             true,
-	"stts.in":              true,
-	"sttu.info":            true,
-	"stuf.in":              true,
-	"sumal.ly":             true,
-	"suo.fyi":              true,
-	"suo.im":               true,
-	"supr.cl":              true,
-	"supr.link":            true,
-	"surl.li":              true,
-	"svy.mk":               true,
-	"swa.is":               true,
-	"swag.run":             true,
-	"swiy.co":              true,
-	"swoo.sh":              true,
-	"swtt.cc":              true,
-	"sy.to":                true,
-	"syb.la":               true,
-	"synd.co":              true,
-	"syw.co":               true,
-	"t-bi.link":            true,
-	"t-mo.co":              true,
-	"t.cn":                 true,
-	"t.co":                 true,
-	"t.iotex.me":           true,
-	"t.libren.ms":          true,
-	"t.ly":                 true,
-	"t.me":                 true,
-	"t.tl":                 true,
-	"t1p.de":               true,
-	"t2m.io":               true,
-	"ta.co":                true,
-	"tabsoft.co":           true,
-	"taiwangov.com":        true,
-	"tanks.ly":             true,
-	"tbb.tw":               true,
-	"tbrd.co":              true,
-	"tcat.tc":              true,
-	"tcrn.ch":              true,
-	"tdrive.li":            true,
-	"tdy.sg":               true,
-	"tek.io":               true,
-	"ter.li":               true,
-	"tg.pe":                true,
-	"tgam.ca":              true,
-	"tgr.ph":               true,
-	"thatis.me":            true,
-	"thd.co":               true,
-	"thedo.do":             true,
-	"thefp.pub":            true,
-	"thein.fo":             true,
-	"thesne.ws":            true,
-	"thetim.es":            true,
-	"thght.works":          true,
-	"thinfi.com":           true,
-	"thls.co":              true,
-	"thn.news":             true,
-	"thr.cm":               true,
-	"thrill.to":            true,
-	"ti.me":                true,
-	"tibco.cm":             true,
-	"tibco.co":             true,
-	"tidd.ly":              true,
-	"tim.com.vc":           true,
-	"tinu.be":              true,
-	"tiny.cc":              true,
-	"tiny.ee":              true,
-	"tiny.one":             true,
-	"tiny.pl":              true,
-	"tinyarro.ws":          true,
-	"tinylink.net":         true,
-	"tinyurl.com":          true,
-	"tinyurl.hu":           true,
-	"tinyurl.mobi":         true,
-	"tktwb.tw":             true,
-	"tl.gd":                true,
-	"tlil.nl":              true,
-	"tlrk.it":              true,
-	"tmblr.co":             true,
-	"tmsnrt.rs":            true,
-	"tmz.me":               true,
-	"tnne.ws":              true,
-	"tnsne.ws":             true,
-	"tnvge.co":             true,
-	"tnw.to":               true,
-	"tny.cz":               true,
-	"tny.im":               true,
-	"tny.so":               true,
-	"to.ly":                true,
-	"to.pbs.org":           true,
-	"toi.in":               true,
-	"tokopedia.link":       true,
-	"tonyr.co":             true,
-	"topt.al":              true,
-	"toyota.us":            true,
-	"tpc.io":               true,
-	"tpmr.com":             true,
-	"tprk.us":              true,
-	"trackurl.link":        true,
-	"trade.re":             true,
-	"travl.rs":             true,
-	"trib.al":              true,
-	"trib.in":              true,
-	"troy.hn":              true,
-	"trt.sh":               true,
-	"trymongodb.com":       true,
-	"tsbk.tw":              true,
-	"tsta.rs":              true,
-	"tt.vg":                true,
-	"tvote.org":            true,
-	"tw.rog.gg":            true,
-	"tw.sv":                true,
-	"twb.nz":               true,
-	"twiturl.de":           true,
-	"twm5g.co":             true,
-	"twou.co":              true,
-	"twtr.to":              true,
-	"txdl.top":             true,
-	"txul.cn":              true,
-	"u.nu":                 true,
-	"u.shxj.pw":            true,
-	"u.to":                 true,
-	"u1.mnge.co":           true,
-	"u6v.cn":               true,
-	"ua.rog.gg":            true,
-	"uafly.co":             true,
-	"ubm.io":               true,
-	"ubnt.link":            true,
-	"ubr.to":               true,
-	"ucbexed.org":          true,
-	"ucla.in":              true,
-	"ufcqc.link":           true,
-	"ugp.io":               true,
-	"ui8.ru":               true,
-	"uk.rog.gg":            true,
-	"ukf.me":               true,
-	"ukoeln.de":            true,
-	"ul.rs":                true,
-	"ul.to":                true,
-	"ul3.ir":               true,
-	"ulvis.net":            true,
-	"ume.la":               true,
-	"umlib.us":             true,
-	"unc.live":             true,
-	"undrarmr.co":          true,
-	"uni.cf":               true,
-	"unipapa.co":           true,
-	"unixgaming.dev":       true,
-	"uofr.us":              true,
-	"uoft.me":              true,
-	"up.to":                true,
-	"upmchp.us":            true,
-	"ur3.us":               true,
-	"urb.tf":               true,
-	"urbn.is":              true,
-	"url.cn":               true,
-	"url.cy":               true,
-	"url.ie":               true,
-	"url2.fr":              true,
-	"urla.ru":              true,
-	"urlbunch.com":         true,
-	"urlday.cc":            true,
-	"urlgeni.us":           true,
-	"urli.ai":              true,
-	"urlify.cn":            true,
-	"urlr.me":              true,
-	"urls.fr":              true,
-	"urls.kr":              true,
-	"urluno.com":           true,
-	"urly.co":              true,
-	"urly.fi":              true,
-	"urlz.fr":              true,
-	"urlzs.com":            true,
-	"urt.io":               true,
-	"us.rog.gg":            true,
-	"usanet.tv":            true,
-	"usat.ly":              true,
-	"usm.ag":               true,
-	"utm.to":               true,
-	"utn.pl":               true,
-	"utraker.com":          true,
-	"v.gd":                 true,
-	"v.redd.it":            true,
-	"vai.la":               true,
-	"vbly.us":              true,
-	"vd55.com":             true,
-	"vercel.link":          true,
-	"vi.sa":                true,
-	"vi.tc":                true,
-	"viaalto.me":           true,
-	"viaja.am":             true,
-	"vineland.dj":          true,
-	"viraln.co":            true,
-	"vivo.tl":              true,
-	"vk.cc":                true,
-	"vk.sv":                true,
-	"vn.rog.gg":            true,
-	"vntyfr.com":           true,
-	"vo.la":                true,
-	"vodafone.uk":          true,
-	"vogue.cm":             true,
-	"voicetu.be":           true,
-	"volvocars.us":         true,
-	"vonq.io":              true,
-	"vrnda.us":             true,
-	"vtns.io":              true,
-	"vur.me":               true,
-	"vurl.com":             true,
-	"vvnt.co":              true,
-	"vxn.link":             true,
-	"vypij.bar":            true,
-	"vz.to":                true,
-	"vzturl.com":           true,
-	"w.idg.de":             true,
-	"w.tt":                 true,
-	"w.wiki":               true,
-	"w5n.co":               true,
-	"wa.link":              true,
-	"wa.me":                true,
-	"wa.sv":                true,
-	"waa.ai":               true,
-	"waad.co":              true,
-	"wahoowa.net":          true,
-	"walk.sc":              true,
-	"walkjc.org":           true,
-	"wapo.st":              true,
-	"warby.me":             true,
-	"warp.plus":            true,
-	"wartsi.ly":            true,
-	"way.to":               true,
-	"wb.md":                true,
-	"wbby.co":              true,
-	"wbur.fm":              true,
-	"wbze.de":              true,
-	"wcha.it":              true,
-	"we.co":                true,
-	"weall.vote":           true,
-	"weare.rs":             true,
-	"wee.so":               true,
-	"wef.ch":               true,
-	"wellc.me":             true,
-	"wenk.io":              true,
-	"westm.in":             true,
-	"wf0.xin":              true,
-	"wfts.tv":              true,
-	"whatel.se":            true,
-	"whcs.law":             true,
-	"whi.ch":               true,
-	"whoel.se":             true,
-	"whr.tn":               true,
-	"wi.se":                true,
-	"win.gs":               true,
-	"wit.to":               true,
-	"wjcf.co":              true,
-	"wkf.ms":               true,
-	"wmojo.com":            true,
-	"wn.nr":                true,
-	"wndrfl.co":            true,
-	"wo.ws":                true,
-	"wooo.tw":              true,
-	"wp.me":                true,
-	"wpbeg.in":             true,
-	"wrctr.co":             true,
-	"wrd.cm":               true,
-	"wrem.it":              true,
-	"wu.to":                true,
-	"wun.io":               true,
-	"ww7.fr":               true,
-	"wwf.to":               true,
-	"wwhts.com":            true,
-	"wwp.news":             true,
-	"www.shrunken.com":     true,
-	"x.gd":                 true,
-	"xbx.lv":               true,
-	"xerox.bz":             true,
-	"xfin.tv":              true,
-	"xfl.ag":               true,
-	"xfru.it":              true,
-	"xgam.es":              true,
-	"xor.tw":               true,
-	"xpr.li":               true,
-	"xprt.re":              true,
-	"xqss.org":             true,
-	"xrds.ca":              true,
-	"xrl.us":               true,
-	"xtra.li":              true,
-	"xurl.es":              true,
-	"xvirt.it":             true,
-	"xxl.frl":              true,
-	"xy2.eu":               true,
-	"xyvid.tv":             true,
-	"y.ahoo.it":            true,
-	"y2u.be":               true,
-	"yadi.sk":              true,
-	"yal.su":               true,
-	"yelp.to":              true,
-	"yex.tt":               true,
-	"yhoo.it":              true,
-	"yip.su":               true,
-	"yji.tw":               true,
-	"ynews.page.link":      true,
-	"yoox.ly":              true,
-	"your.ls":              true,
-	"yourls.org":           true,
-	"yourwish.es":          true,
-	"youtu.be":             true,
-	"yubi.co":              true,
-	"yuk.nu":               true,
-	"yun.ir":               true,
-	"z23.ru":               true,
-	"zat.ink":              true,
-	"zaya.io":              true,
-	"zc.vg":                true,
-	"zcu.io":               true,
-	"zd.net":               true,
-	"zdrive.li":            true,
-	"zdsk.co":              true,
-	"zecz.ec":              true,
-	"zeep.ly":              true,
-	"zez.kr":               true,
-	"zi.ma":                true,
-	"ziadi.co":             true,
-	"zipurl.fr":            true,
-	"zln.do":               true,
-	"zlr.my":               true,
-	"zlra.co":              true,
-	"zlw.re":               true,
-	"zoho.to":              true,
-	"zopen.to":             true,
-	"zovpart.com":          true,
-	"zpr.io":               true,
-	"zuki.ie":              true,
-	"zuplo.link":           true,
-	"zurb.us":              true,
-	"zurins.uk":            true,
-	"zurl.co":              true,
-	"zurl.ir":              true,
-	"zurl.ws":              true,
-	"zws.im":               true,
-	"zxc.li":               true,
-	"zynga.my":             true,
-	"zywv.us":              true,
-	"zzb.bz":               true,
-	"zzu.info":             true,
-	"1-url.net":            true,
-	"126.am":               true,
-	"1link.in":             true,
-	"1tk.us":               true,
-	"1url.com":             true,
-	"1wb2.net":             true,
-	"23o.net":              true,
-	"2ad.in":               true,
-	"2big.at":              true,
-	"2tu.us":               true,
-	"2ty.in":               true,
-	"2u.xf.cz":             true,
-	"2ya.com":              true,
-	"3ra.be":               true,
-	"3x.si":                true,
-	"4i.ae":                true,
-	"4url.cc":              true,
-	"4view.me":             true,
-	"5em.cz":               true,
-	"5url.net":             true,
-	"5z8.info":             true,
-	"6fr.ru":               true,
-	"6url.com":             true,
-	"76.gd":                true,
-	"77.ai":                true,
-	"7fth.cc":              true,
-	"7li.in":               true,
-	"7vd.cn":               true,
-	"8u.cz":                true,
-	"944.la":               true,
-	"98.to":                true,
-	"9m.no":                true,
-	"AltURL.com":           true,
-	"BudURL.com":           true,
-	"Buff.ly":              true,
-	"BurnURL.com":          true,
-	"C-O.IN":               true,
-	"ClickMeter.com":       true,
-	"DecentURL.com":        true,
-	"DigBig.com":           true,
-	"Digg.com":             true,
-	"DwarfURL.com":         true,
-	"EasyURI.com":          true,
-	"EasyURL.net":          true,
-	"EsyURL.com":           true,
-	"Fhurl.com":            true,
-	"Fly2.ws":              true,
-	"GoWat.ch":             true,
-	"Hurl.it":              true,
-	"IsCool.net":           true,
-	"Just.as":              true,
-	"L9.fr":                true,
-	"Lvvk.com":             true,
-	"MyURL.in":             true,
-	"PiURL.com":            true,
-	"Profile.to":           true,
-	"QLNK.net":             true,
-	"Quip-Art.com":         true,
-	"RedirX.com":           true,
-	"Sharein.com":          true,
-	"ShortLinks.co.uk":     true,
-	"Shrinkify.com":        true,
-	"SimURL.com":           true,
-	"StartURL.com":         true,
-	"TightURL.com":         true,
-	"Tnij.org":             true,
-	"To8.cc":               true,
-	"TraceURL.com":         true,
-	"URL.ie":               true,
-	"URLHawk.com":          true,
-	"WapURL.co.uk":         true,
-	"XeeURL.com":           true,
-	"Yep.it":               true,
-	"a.gg":                 true,
-	"a.nf":                 true,
-	"a0.fr":                true,
-	"a2a.me":               true,
-	"abbr.sk":              true,
-	"abbrr.com":            true,
-	"ad-med.cz":            true,
-	"ad5.eu":               true,
-	"ad7.biz":              true,
-	"adfa.st":              true,
-	"adfly.fr":             true,
-	"adjix.com":            true,
-	"adli.pw":              true,
-	"admy.link":            true,
-	"adv.li":               true,
-	"ajn.me":               true,
-	"aka.gr":               true,
-	"al.ly":                true,
-	"alil.in":              true,
-	"any.gs":               true,
-	"aqva.pl":              true,
-	"ares.tl":              true,
-	"asso.in":              true,
-	"atu.ca":               true,
-	"au.ms":                true,
-	"ayt.fr":               true,
-	"azali.fr":             true,
-	"b00.fr":               true,
-	"b54.in":               true,
-	"bacn.me":              true,
-	"baid.us":              true,
-	"bee4.biz":             true,
-	"bim.im":               true,
-	"bit.do":               true,
-	"bitw.in":              true,
-	"bkite.com":            true,
-	"ble.pl":               true,
-	"blip.tv":              true,
-	"bloat.me":             true,
-	"boi.re":               true,
-	"bote.me":              true,
-	"bougn.at":             true,
-	"brk.to":               true,
-	"brzu.net":             true,
-	"buk.me":               true,
-	"bul.lu":               true,
-	"bxl.me":               true,
-	"cachor.ro":            true,
-	"captur.in":            true,
-	"catchylink.com":       true,
-	"cbs.so":               true,
-	"cbug.cc":              true,
-	"ccj.im":               true,
-	"cf.ly":                true,
-	"cf2.me":               true,
-	"cf6.co":               true,
-	"cjb.net":              true,
-	"cli.gs":               true,
-	"clikk.in":             true,
-	"cn86.org":             true,
-	"coinurl.com":          true,
-	"couic.fr":             true,
-	"cr.tl":                true,
-	"cudder.it":            true,
-	"cur.lv":               true,
-	"curl.im":              true,
-	"cut.sk":               true,
-	"cutt.eu":              true,
-	"cutu.me":              true,
-	"cuturl.com":           true,
-	"cybr.fr":              true,
-	"cyonix.to":            true,
-	"d75.eu":               true,
-	"daa.pl":               true,
-	"ddp.net":              true,
-	"decenturl.com":        true,
-	"dfl8.me":              true,
-	"dft.ba":               true,
-	"doiop.com":            true,
-	"dolp.cc":              true,
-	"droid.ws":             true,
-	"dyo.gs":               true,
-	"e37.eu":               true,
-	"ecra.se":              true,
-	"ely.re":               true,
-	"emojilink.me":         true,
-	"erax.cz":              true,
-	"erw.cz":               true,
-	"ewerl.com":            true,
-	"ex9.co":               true,
-	"ezurl.cc":             true,
-	"fa.b":                 true,
-	"fff.re":               true,
-	"fff.to":               true,
-	"fff.wf":               true,
-	"filz.fr":              true,
-	"fire.to":              true,
-	"fly2.ws":              true,
-	"fnk.es":               true,
-	"foe.hn":               true,
-	"folu.me":              true,
-	"fon.gs":               true,
-	"freze.it":             true,
-	"fur.ly":               true,
-	"fwd4.me":              true,
-	"g00.me":               true,
-	"git.io":               true,
-	"gl.am":                true,
-	"go.9nl.com":           true,
-	"go2.me":               true,
-	"go2cut.com":           true,
-	"goo.lu":               true,
-	"good.ly":              true,
-	"goshrink.com":         true,
-	"grem.io":              true,
-	"gri.ms":               true,
-	"guiama.is":            true,
-	"gurl.es":              true,
-	"hadej.co":             true,
-	"hec.su":               true,
-	"hellotxt.com":         true,
-	"hex.io":               true,
-	"hide.my":              true,
-	"hjkl.fr":              true,
-	"hops.me":              true,
-	"hover.com":            true,
-	"href.in":              true,
-	"htxt.it":              true,
-	"hugeurl.com":          true,
-	"hurl.me":              true,
-	"hurl.ws":              true,
-	"i-2.co":               true,
-	"i99.cz":               true,
-	"icanhaz.com":          true,
-	"ick.li":               true,
-	"idek.net":             true,
-	"iiiii.in":             true,
-	"iky.fr":               true,
-	"ilix.in":              true,
-	"info.ms":              true,
-	"inreply.to":           true,
-	"isra.li":              true,
-	"iterasi.net":          true,
-	"itm.im":               true,
-	"jdem.cz":              true,
-	"jieb.be":              true,
-	"jijr.com":             true,
-	"jmp2.net":             true,
-	"jp22.net":             true,
-	"jqw.de":               true,
-	"kd2.org":              true,
-	"kfd.pl":               true,
-	"kissa.be":             true,
-	"kl.am":                true,
-	"korta.nu":             true,
-	"kr3w.de":              true,
-	"krat.si":              true,
-	"kratsi.cz":            true,
-	"krod.cz":              true,
-	"krunchd.com":          true,
-	"kuc.cz":               true,
-	"kxb.me":               true,
-	"l-k.be":               true,
-	"l.gg":                 true,
-	"lc-s.co":              true,
-	"lcut.in":              true,
-	"letop10.":             true,
-	"libero.it":            true,
-	"lick.my":              true,
-	"lien.li":              true,
-	"lien.pl":              true,
-	"liltext.com":          true,
-	"lin.cr":               true,
-	"lin.io":               true,
-	"linkbee.com":          true,
-	"linkbun.ch":           true,
-	"linkn.co":             true,
-	"liurl.cn":             true,
-	"llu.ch":               true,
-	"ln-s.net":             true,
-	"ln-s.ru":              true,
-	"lnk.co":               true,
-	"lnk.gd":               true,
-	"lnk.in":               true,
-	"lnk.ly":               true,
-	"lnked.in":             true,
-	"lnks.fr":              true,
-	"lnky.fr":              true,
-	"lnp.sn":               true,
-	"loopt.us":             true,
-	"lp25.fr":              true,
-	"lt.tl":                true,
-	"lurl.no":              true,
-	"lynk.my":              true,
-	"m3mi.com":             true,
-	"make.my":              true,
-	"mcaf.ee":              true,
-	"mdl29.net":            true,
-	"mic.fr":               true,
-	"minilien.com":         true,
-	"miniurl.com":          true,
-	"minu.me":              true,
-	"minurl.fr":            true,
-	"moourl.com":           true,
-	"more.sh":              true,
-	"mut.lu":               true,
-	"myurl.in":             true,
-	"ne1.net":              true,
-	"net.ms":               true,
-	"net46.net":            true,
-	"nicou.ch":             true,
-	"nig.gr":               true,
-	"niny.io":              true,
-	"njx.me":               true,
-	"nn.nf":                true,
-	"nov.io":               true,
-	"nq.st":                true,
-	"nsfw.in":              true,
-	"nxy.in":               true,
-	"o-x.fr":               true,
-	"okok.fr":              true,
-	"om.ly":                true,
-	"ou.af":                true,
-	"ou.gd":                true,
-	"oua.be":               true,
-	"p.pw":                 true,
-	"para.pt":              true,
-	"parky.tv":             true,
-	"past.is":              true,
-	"pd.am":                true,
-	"pdh.co":               true,
-	"ph.dog":               true,
-	"ph.ly":                true,
-	"pic.gd":               true,
-	"pich.in":              true,
-	"pin.st":               true,
-	"ping.fm":              true,
-	"plots.fr":             true,
-	"pm.wu.cz":             true,
-	"pnt.me":               true,
-	"po.st":                true,
-	"poprl.com":            true,
-	"post.ly":              true,
-	"posted.at":            true,
-	"ppfr.it":              true,
-	"ppst.me":              true,
-	"ppt.li":               true,
-	"prejit.cz":            true,
-	"ptab.it":              true,
-	"ptm.ro":               true,
-	"pw2.ro":               true,
-	"py6.ru":               true,
-	"qbn.ru":               true,
-	"qicute.com":           true,
-	"qqc.co":               true,
-	"qrtag.fr":             true,
-	"qxp.cz":               true,
-	"qxp.sk":               true,
-	"rb6.co":               true,
-	"rb6.me":               true,
-	"rcknr.io":             true,
-	"rdz.me":               true,
-	"redir.fr":             true,
-	"redu.it":              true,
-	"ref.so":               true,
-	"reise.lc":             true,
-	"relink.fr":            true,
-	"ri.ms":                true,
-	"rickroll.it":          true,
-	"riz.cz":               true,
-	"riz.gd":               true,
-	"rod.gs":               true,
-	"roflc.at":             true,
-	"rsmonkey.com":         true,
-	"rt.se":                true,
-	"rt.tc":                true,
-	"ru.ly":                true,
-	"rubyurl.com":          true,
-	"s-url.fr":             true,
-	"s7y.us":               true,
-	"safe.mn":              true,
-	"sagyap.tk":            true,
-	"seeme.at":             true,
-	"segue.se":             true,
-	"sh.st":                true,
-	"sharetabs.com":        true,
-	"short.cc":             true,
-	"short.ie":             true,
-	"short.nr":             true,
-	"short.pk":             true,
-	"short.to":             true,
-	"shorte.st":            true,
-	"shortna.me":           true,
-	"shoturl.us":           true,
-	"shrinkee.com":         true,
-	"shrinkster.com":       true,
-	"shrinkurl.in":         true,
-	"shrt.in":              true,
-	"shrt.st":              true,
-	"shrten.com":           true,
-	"shrunkin.com":         true,
-	"shw.me":               true,
-	"shy.si":               true,
-	"sicax.net":            true,
-	"sk.gy":                true,
-	"skroc.pl":             true,
-	"smll.co":              true,
-	"sn.im":                true,
-	"sn.vc":                true,
-	"snipr.com":            true,
-	"snipurl.com":          true,
-	"snsw.us":              true,
-	"snurl.com":            true,
-	"soo.gd":               true,
-	"sp2.ro":               true,
-	"spedr.com":            true,
-	"spn.sr":               true,
-	"sq6.ru":               true,
-	"sqrl.it":              true,
-	"ssl.gs":               true,
-	"sturly.com":           true,
-	"su.pr":                true,
-	"surl.me":              true,
-	"sux.cz":               true,
-	"sy.pe":                true,
-	"ta.gd":                true,
-	"tabzi.com":            true,
-	"tau.pe":               true,
-	"tdjt.cz":              true,
-	"thesa.us":             true,
-	"thrdl.es":             true,
-	"tin.li":               true,
-	"tini.cc":              true,
-	"tiny.lt":              true,
-	"tiny.ms":              true,
-	"tiny123.com":          true,
-	"tinytw.it":            true,
-	"tinyuri.ca":           true,
-	"tinyvid.io":           true,
-	"tixsu.com":            true,
-	"tldr.sk":              true,
-	"tldrify.com":          true,
-	"tllg.net":             true,
-	"tnij.org":             true,
-	"togoto.us":            true,
-	"tohle.de":             true,
-	"tr.im":                true,
-	"tr.my":                true,
-	"tr5.in":               true,
-	"trck.me":              true,
-	"trick.ly":             true,
-	"trkr.ws":              true,
-	"trunc.it":             true,
-	"turo.us":              true,
-	"tweetburner.com":      true,
-	"twet.fr":              true,
-	"twi.im":               true,
-	"twirl.at":             true,
-	"twit.ac":              true,
-	"twitterpan.com":       true,
-	"twitthis.com":         true,
-	"twlr.me":              true,
-	"twurl.cc":             true,
-	"twurl.nl":             true,
-	"u.mavrev.com":         true,
-	"u6e.de":               true,
-	"ub0.cc":               true,
-	"uby.es":               true,
-	"ucam.me":              true,
-	"ug.cz":                true,
-	"ulmt.in":              true,
-	"unlc.us":              true,
-	"updating.me":          true,
-	"upzat.com":            true,
-	"ur1.ca":               true,
-	"url.co.uk":            true,
-	"url4.eu":              true,
-	"url5.org":             true,
-	"urlao.com":            true,
-	"urlbrief.com":         true,
-	"urlcover.com":         true,
-	"urlcut.com":           true,
-	"urlenco.de":           true,
-	"urlin.it":             true,
-	"urlkiss.com":          true,
-	"urlkr.com":            true,
-	"urlot.com":            true,
-	"urlpire.com":          true,
-	"urlx.ie":              true,
-	"urlx.org":             true,
-	"urlzen.com":           true,
-	"urub.us":              true,
-	"utfg.sk":              true,
-	"v.ht":                 true,
-	"v5.gd":                true,
-	"vaaa.fr":              true,
-	"valv.im":              true,
-	"vaza.me":              true,
-	"verd.in":              true,
-	"vgn.me":               true,
-	"virl.com":             true,
-	"vl.am":                true,
-	"vov.li":               true,
-	"vsll.eu":              true,
-	"vt802.us":             true,
-	"vv.vg":                true,
-	"w1p.fr":               true,
-	"w3t.org":              true,
-	"wb1.eu":               true,
-	"web99.eu":             true,
-	"wed.li":               true,
-	"wideo.fr":             true,
-	"wipi.es":              true,
-	"wtc.la":               true,
-	"wu.cz":                true,
-	"wwy.me":               true,
-	"x.co":                 true,
-	"x.nu":                 true,
-	"x.se":                 true,
-	"x10.mx":               true,
-	"x2c.eu":               true,
-	"x2c.eumx":             true,
-	"xaddr.com":            true,
-	"xav.cc":               true,
-	"xgd.in":               true,
-	"xib.me":               true,
-	"xl8.eu":               true,
-	"xn--ki8h.ws":          true,
-	"xn--kn8h.to":          true,
-	"xn--vi8hl0c.ws":       true,
-	"xoe.cz":               true,
-	"xr.com":               true,
-	"xrl.in":               true,
-	"xt3.me":               true,
-	"xua.me":               true,
-	"xub.me":               true,
-	"xurl.jp":              true,
-	"xurls.co":             true,
-	"xzb.cc":               true,
-	"yagoa.fr":             true,
-	"yagoa.me":             true,
-	"yau.sh":               true,
-	"yeca.eu":              true,
-	"yect.com":             true,
-	"yep.it":               true,
-	"yfrog.com":            true,
-	"yogh.me":              true,
-	"yon.ir":               true,
-	"youfap.me":            true,
-	"ysear.ch":             true,
-	"yweb.com":             true,
-	"yyv.co":               true,
-	"z9.fr":                true,
-	"zSMS.net":             true,
-	"zapit.nu":             true,
-	"zeek.ir":              true,
-	"zi.pe":                true,
-	"zip.net":              true,
-	"zipmyurl.com":         true,
-	"zkr.cz":               true,
-	"zkrat.me":             true,
-	"zkrt.cz":              true,
-	"zoodl.com":            true,
-	"zpag.es":              true,
-	"zti.me":               true,
-	"zxq.net":              true,
-	"zyva.org":             true,
-	"zz.gd":                true,
-}
@@ -0,0 +1,131 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/surfe/utils/urls"
+	"golang.org/x/net/publicsuffix"
+)
+
+var (
+	ErrEmptyHost  = errors.New("empty host")
+	ErrNoDomain   = errors.New("no registrable domain")
+	ErrInvalidIDN = errors.New("invalid internationalized domain name")
+)
+
+// Scheme of a URL with an authority, per RFC 3986, e.g. "https://" or "ftp://"
+var reURLScheme = regexp.MustCompile(`^[a-z][a-z0-9+.\-]*://`)
+
+// WebAddress is a URL or domain parsed by ParseWebAddress. For "https://app.surfe.co.uk:8080/blog/":
+//
+//	Scheme: "https", Host: "app.surfe.co.uk", Port: "8080", Path: "/blog/",
+//	Subdomain: "app", Domain: "surfe.co.uk", TLD: "co.uk"
+type WebAddress struct {
+	Scheme    string // Lowercased, empty when missing
+	Host      string // Lowercased, without port nor trailing dot, in the requested DomainForm
+	Port      string // Empty when missing
+	Path      string // Unescaped, e.g. "/blog/"
+	Subdomain string // Labels before Domain, "www" included, e.g. "www.app"
+	Domain    string // Registrable domain, or the host when it's a public suffix itself, e.g. "co.uk"
+	TLD       string // Public suffix of Domain, e.g. "co.uk" for "surfe.co.uk", "uk" for "co.uk"
+
+	IsIP                    bool // Host is an IPv4 or IPv6 address, which has no domain
+	IsPublicPlatform        bool // Host is, or is a subdomain of, a social media or website builder, see urls.IsPublicDomain
+	IsShortener             bool // Host is, or is a subdomain of, a URL shortener, see urls.IsURLShortenerDomain
+	IsPersonalEmailProvider bool // Host is, or is a subdomain of, a personal email provider, see IsPersonalEmailDomain
+}
+
+// ParseWebAddress parses a URL, with or without scheme, or a bare domain. Domains are split with the public suffix
+// list, whose private section is honored, e.g. "acme.uk.com" is a registrable domain. Internationalized hosts are
+// returned in the optional form, that of the host of s by default, see DomainForm. On error, the fields parsed so
+// far are set, e.g. the host of "http://localhost:3000", which has no domain. Examples:
+//
+//	ParseWebAddress("www.surfe.com")                -> Host: "www.surfe.com", Subdomain: "www", Domain: "surfe.com"
+//	ParseWebAddress("https://bit.ly/3qs9ftN")       -> Domain: "bit.ly", IsShortener
+//	ParseWebAddress("http://192.168.1.1/")          -> Host: "192.168.1.1", IsIP, ErrNoDomain
+//	ParseWebAddress("ftp://files.acme.com/x")       -> Scheme: "ftp", Host: "files.acme.com", Domain: "acme.com"
+//	ParseWebAddress("müller.de", DomainFormASCII)   -> Host: "xn--mller-kva.de", Domain: "xn--mller-kva.de"
+//	ParseWebAddress("xn--mller-kva.de", DomainFormUnicode) -> Host: "müller.de", Domain: "müller.de"
+func ParseWebAddress(s string, form ...DomainForm) (WebAddress, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return WebAddress{}, ErrEmptyURL
+	}
+
+	if !reURLScheme.MatchString(strings.ToLower(s)) {
+		s = "//" + s // URL needs to be prefixed with `//` to be parseable
+	}
+
+	u, err := url.Parse(s)
+	if err != nil {
+		return WebAddress{}, fmt.Errorf("parse URL: %w", err)
+	}
+
+	address := WebAddress{
+		Scheme: strings.ToLower(u.Scheme),
+		Host:   strings.TrimSuffix(strings.ToLower(u.Hostname()), "."),
+		Port:   u.Port(),
+		Path:   u.Path,
+	}
+
+	if address.Host == "" {
+		return address, ErrEmptyHost
+	}
+
+	if net.ParseIP(address.Host) != nil {
+		address.IsIP = true
+
+		return address, ErrNoDomain
+	}
+
+	// The public suffix list and domain lists are in ASCII form
+	if address.Host, err = ConvertDomain(address.Host, DomainFormASCII); err != nil {
+		address.Host = strings.TrimSuffix(strings.ToLower(u.Hostname()), ".")
+
+		return address, fmt.Errorf("%w %q: %w", ErrInvalidIDN, address.Host, err)
+	}
+
+	address.Domain, err = publicsuffix.EffectiveTLDPlusOne(address.Host)
+	if err != nil {
+		// Hosts which are a public suffix are domains too, e.g. "co.uk", unlike single labels, e.g. "localhost"
+		if !strings.Contains(address.Host, ".") || strings.HasPrefix(address.Host, ".") {
+			return address, fmt.Errorf("%w in %q: %w", ErrNoDomain, address.Host, err)
+		}
+
+		address.Domain = address.Host
+	}
+
+	address.TLD, _ = publicsuffix.PublicSuffix(address.Domain)
+	if address.TLD == address.Domain {
+		address.TLD = address.Domain[strings.IndexByte(address.Domain, '.')+1:]
+	}
+
+	address.Subdomain = strings.TrimSuffix(strings.TrimSuffix(address.Host, address.Domain), ".")
+	address.IsPublicPlatform = urls.IsPublicDomain(address.Host)
+	address.IsShortener = urls.IsURLShortenerDomain(address.Host)
+	address.IsPersonalEmailProvider = IsPersonalEmailDomain(address.Host)
+
+	if domainFormOf(u.Hostname(), form) == DomainFormUnicode {
+		address.Host, _ = ConvertDomain(address.Host, DomainFormUnicode)
+		address.Subdomain, _ = ConvertDomain(address.Subdomain, DomainFormUnicode)
+		address.Domain, _ = ConvertDomain(address.Domain, DomainFormUnicode)
+		address.TLD, _ = ConvertDomain(address.TLD, DomainFormUnicode)
+	}
+
+	return address, nil
+}
+
+// HostWithoutWWW returns Host without its "www." prefix, e.g. "app.surfe.com" for "www.app.surfe.com".
+func (a WebAddress) HostWithoutWWW() string {
+	return strings.TrimPrefix(a.Host, "www.")
+}
+
+// Name returns Domain without its TLD, e.g. "surfe" for "surfe.co.uk".
+func (a WebAddress) Name() string {
+	return strings.TrimSuffix(a.Domain, "."+a.TLD)
+}
@@ -0,0 +1,139 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseWebAddress(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		input       string
+		expected    WebAddress
+		expectedErr error
+	}{
+		{
+			input: "https://App.Surfe.co.uk:8080/blog/?q=1#top",
+			expected: WebAddress{
+				Scheme:    "https",
+				Host:      "app.surfe.co.uk",
+				Port:      "8080",
+				Path:      "/blog/",
+				Subdomain: "app",
+				Domain:    "surfe.co.uk",
+				TLD:       "co.uk",
+			},
+		},
+		{
+			input:    "www.surfe.com",
+			expected: WebAddress{Host: "www.surfe.com", Subdomain: "www", Domain: "surfe.com", TLD: "com"},
+		},
+		{
+			input: "FTP://files.acme.com/x",
+			expected: WebAddress{
+				Scheme:    "ftp",
+				Host:      "files.acme.com",
+				Path:      "/x",
+				Subdomain: "files",
+				Domain:    "acme.com",
+				TLD:       "com",
+			},
+		},
+		{
+			input:    "surfe.com.",
+			expected: WebAddress{Host: "surfe.com", Domain: "surfe.com", TLD: "com"},
+		},
+		{
+			input: "https://acme.uk.com/path",
+			expected: WebAddress{
+				Scheme: "https",
+				Host:   "acme.uk.com",
+				Path:   "/path",
+				Domain: "acme.uk.com",
+				TLD:    "uk.com",
+			},
+		},
+		{
+			input:    "co.uk",
+			expected: WebAddress{Host: "co.uk", Domain: "co.uk", TLD: "uk"},
+		},
+		{
+			input: "https://bit.ly/3qs9ftN",
+			expected: WebAddress{
+				Scheme:      "https",
+				Host:        "bit.ly",
+				Path:        "/3qs9ftN",
+				Domain:      "bit.ly",
+				TLD:         "ly",
+				IsShortener: true,
+			},
+		},
+		{
+			input: "https://www.linkedin.com/company/surfe",
+			expected: WebAddress{
+				Scheme:           "https",
+				Host:             "www.linkedin.com",
+				Path:             "/company/surfe",
+				Subdomain:        "www",
+				Domain:           "linkedin.com",
+				TLD:              "com",
+				IsPublicPlatform: true,
+			},
+		},
+		{
+			input: "mail.yahoo.co.uk",
+			expected: WebAddress{
+				Host:                    "mail.yahoo.co.uk",
+				Subdomain:               "mail",
+				Domain:                  "yahoo.co.uk",
+				TLD:                     "co.uk",
+				IsPersonalEmailProvider: true,
+			},
+		},
+		{
+			input:       "http://192.168.1.1:3000/admin",
+			expected:    WebAddress{Scheme: "http", Host: "192.168.1.1", Port: "3000", Path: "/admin", IsIP: true},
+			expectedErr: ErrNoDomain,
+		},
+		{
+			input:       "http://[::1]/",
+			expected:    WebAddress{Scheme: "http", Host: "::1", Path: "/", IsIP: true},
+			expectedErr: ErrNoDomain,
+		},
+		{
+			input:       "http://localhost:3000",
+			expected:    WebAddress{Scheme: "http", Host: "localhost", Port: "3000"},
+			expectedErr: ErrNoDomain,
+		},
+		{
+			input:       "https:///surfe.com",
+			expected:    WebAddress{Scheme: "https", Path: "/surfe.com"},
+			expectedErr: ErrEmptyHost,
+		},
+		{
+			input:       " ",
+			expectedErr: ErrEmptyURL,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			t.Parallel()
+
+			address, err := ParseWebAddress(tt.input)
+			require.ErrorIs(t, err, tt.expectedErr)
+			require.Equal(t, tt.expected, address)
+		})
+	}
+}
+
+func TestWebAddressMethods(t *testing.T) {
+	t.Parallel()
+
+	address, err := ParseWebAddress("https://www.app.surfe.co.uk")
+	require.NoError(t, err)
+	require.Equal(t, "app.surfe.co.uk", address.HostWithoutWWW())
+	require.Equal(t, "surfe", address.Name())
+}